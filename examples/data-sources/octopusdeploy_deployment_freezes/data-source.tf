@@ -0,0 +1,5 @@
+data "octopusdeploy_deployment_freezes" "example" {
+  active_at   = "2024-12-25T09:00:00+10:00"
+  project_ids = ["Projects-123"]
+  take        = 100
+}
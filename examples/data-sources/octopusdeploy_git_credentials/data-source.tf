@@ -0,0 +1,3 @@
+data "octopusdeploy_git_credentials" "example" {
+  name = "GitHub"
+}
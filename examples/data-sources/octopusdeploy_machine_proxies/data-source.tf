@@ -0,0 +1,5 @@
+data "octopusdeploy_machine_proxies" "example" {
+  partial_name = "Corporate"
+  skip         = 5
+  take         = 100
+}
@@ -0,0 +1,6 @@
+data "octopusdeploy_step_templates" "example" {
+  ids          = ["ActionTemplates-123", "ActionTemplates-321"]
+  partial_name = "Greeting"
+  skip         = 5
+  take         = 100
+}
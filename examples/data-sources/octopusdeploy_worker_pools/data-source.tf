@@ -0,0 +1,7 @@
+data "octopusdeploy_worker_pools" "example" {
+  ids              = ["WorkerPools-123", "WorkerPools-321"]
+  partial_name     = "Ubuntu"
+  skip             = 5
+  take             = 100
+  worker_pool_type = "DynamicWorkerPool"
+}
@@ -0,0 +1,8 @@
+data "octopusdeploy_workers" "example" {
+  communication_styles = ["TentaclePassive"]
+  health_statuses      = ["Healthy", "HasWarnings"]
+  partial_name         = "Ubuntu"
+  skip                 = 5
+  take                 = 100
+  worker_pool_ids      = ["WorkerPools-123"]
+}
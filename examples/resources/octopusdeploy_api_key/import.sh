@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_api_key.<name> "<user-id>:<api-key-id>"
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "octopusdeploy_user" "ci" {
+  display_name  = "CI"
+  email_address = "ci@example.com"
+  is_active     = true
+  is_service    = true
+  username      = "ci"
+}
+
+resource "octopusdeploy_api_key" "ci" {
+  expires = "2025-01-01T00:00:00Z"
+  purpose = "Continuous integration"
+  user_id = octopusdeploy_user.ci.id
+}
+
+output "ci_api_key" {
+  sensitive = true
+  value     = octopusdeploy_api_key.ci.api_key
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_artifactory_generic_feed.<name> <feed-id>
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "octopusdeploy_artifactory_generic_feed" "example" {
+  feed_uri   = "https://example.jfrog.io"
+  name       = "Test Artifactory Generic Feed (OK to Delete)"
+  password   = "test-password"
+  repository = "generic-local"
+  username   = "test-username"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_aws_openid_connect_account.<name> <account-id>
\ No newline at end of file
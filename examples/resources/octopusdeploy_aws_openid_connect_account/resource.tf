@@ -0,0 +1,7 @@
+resource "octopusdeploy_aws_openid_connect_account" "example" {
+  deployment_subject_keys = ["space", "environment", "project", "runbook"]
+  health_subject_keys     = ["space", "target"]
+  name                    = "AWS OpenID Connect Account (OK to Delete)"
+  role_arn                = "arn:aws:iam::123456789012:role/octopus-deploy"
+  session_duration        = 3600
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_azure_openid_connect.<name> <account-id>
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "octopusdeploy_azure_openid_connect" "example" {
+  application_id          = "00000000-0000-0000-0000-000000000000"
+  deployment_subject_keys = ["space", "environment", "project", "runbook"]
+  health_subject_keys     = ["space", "target"]
+  name                    = "Azure OpenID Connect Account (OK to Delete)"
+  subscription_id         = "00000000-0000-0000-0000-000000000000"
+  tenant_id               = "00000000-0000-0000-0000-000000000000"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_built_in_feed_retention.<name> feeds-builtin
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "octopusdeploy_built_in_feed_retention" "example" {
+  delete_unreleased_packages_after_days = 30
+}
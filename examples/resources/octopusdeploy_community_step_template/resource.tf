@@ -0,0 +1,4 @@
+resource "octopusdeploy_community_step_template" "slack" {
+  community_action_template_id = "CommunityActionTemplates-123"
+  version                      = 15
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_deployment.<name> <deployment-id>
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "octopusdeploy_deployment" "example" {
+  comments       = "Deployed by Terraform"
+  environment_id = "Environments-123"
+  release_id     = "Releases-123"
+  tenant_id      = "Tenants-123"
+
+  form_values = {
+    "Approver" = "Jane Doe"
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
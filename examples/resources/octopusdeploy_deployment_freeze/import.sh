@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_deployment_freeze.<name> <deployment-freeze-id>
\ No newline at end of file
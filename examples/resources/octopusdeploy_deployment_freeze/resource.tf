@@ -0,0 +1,39 @@
+resource "octopusdeploy_deployment_freeze" "holidays" {
+  name  = "Holidays"
+  start = "2024-12-24T18:00:00+10:00"
+  end   = "2025-01-02T08:00:00+10:00"
+
+  project {
+    environment_ids = ["Environments-123"]
+    project_id      = "Projects-123"
+  }
+
+  tenant {
+    environment_ids = ["Environments-123"]
+    project_id      = "Projects-123"
+    tenant_id       = "Tenants-123"
+  }
+
+  recurring_schedule {
+    type     = "Annually"
+    unit     = 1
+    end_type = "Never"
+  }
+}
+
+resource "octopusdeploy_deployment_freeze" "weekends" {
+  name  = "Weekends"
+  start = "2024-06-07T17:00:00-05:00"
+  end   = "2024-06-10T07:00:00-05:00"
+
+  project {
+    environment_ids = ["Environments-123"]
+    project_id      = "Projects-123"
+  }
+
+  recurring_schedule {
+    days_of_week = ["Friday"]
+    type         = "Weekly"
+    unit         = 1
+  }
+}
@@ -0,0 +1,4 @@
+resource "octopusdeploy_event_retention" "example" {
+  archived_event_retention_days = 365
+  event_retention_days          = 90
+}
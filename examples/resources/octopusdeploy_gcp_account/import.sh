@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_gcp_account.<name> <account-id>
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "octopusdeploy_gcp_account" "example" {
+  json_key = file("service-account.json") # required; get from secure environment/store
+  name     = "Google Cloud Account (OK to Delete)"
+}
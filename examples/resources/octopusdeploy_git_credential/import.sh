@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_git_credential.<name> <git-credential-id>
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "octopusdeploy_git_credential" "github" {
+  name     = "GitHub"
+  password = "###########" # required; get from secure environment/store
+  username = "octopus-deploy"
+}
+
+resource "octopusdeploy_project" "example" {
+  is_version_controlled = true
+  lifecycle_id          = "Lifecycles-123"
+  name                  = "Version-Controlled Project (OK to Delete)"
+  project_group_id      = "ProjectGroups-123"
+
+  version_control_settings {
+    base_path         = ".octopus"
+    default_branch    = "main"
+    git_credential_id = octopusdeploy_git_credential.github.id
+    url               = "https://github.com/example/repository.git"
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_google_container_registry.<name> <feed-id>
\ No newline at end of file
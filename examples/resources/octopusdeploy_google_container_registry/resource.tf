@@ -0,0 +1,8 @@
+resource "octopusdeploy_google_container_registry" "example" {
+  api_version   = "v2"
+  feed_uri      = "https://gcr.io"
+  name          = "Test Google Container Registry (OK to Delete)"
+  password      = file("service-account.json")
+  registry_path = "example-project"
+  username      = "_json_key"
+}
@@ -0,0 +1,7 @@
+resource "octopusdeploy_listening_tentacle_worker" "example" {
+  machine_policy_id = "MachinePolicies-1"
+  name              = "Listening Tentacle Worker (OK to Delete)"
+  tentacle_url      = "https://example.com:10933/"
+  thumbprint        = "[thumbprint]"
+  worker_pool_ids   = ["WorkerPools-123"]
+}
@@ -0,0 +1,7 @@
+resource "octopusdeploy_machine_proxy" "corporate" {
+  host     = "proxy.example.com"
+  name     = "Corporate Proxy"
+  password = "###########" # get from secure environment/store
+  port     = 3128
+  username = "octopus-deploy"
+}
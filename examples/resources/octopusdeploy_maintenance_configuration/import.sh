@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_maintenance_configuration.<name> maintenance
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "octopusdeploy_maintenance_configuration" "example" {
+  is_in_maintenance_mode = false
+}
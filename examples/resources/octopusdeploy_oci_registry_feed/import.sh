@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_oci_registry_feed.<name> <feed-id>
\ No newline at end of file
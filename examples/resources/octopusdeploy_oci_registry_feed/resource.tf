@@ -0,0 +1,6 @@
+resource "octopusdeploy_oci_registry_feed" "example" {
+  feed_uri = "oci://registry-1.docker.io"
+  name     = "Test OCI Registry Feed (OK to Delete)"
+  password = "test-password"
+  username = "test-username"
+}
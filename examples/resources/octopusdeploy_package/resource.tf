@@ -0,0 +1,20 @@
+resource "octopusdeploy_package" "example" {
+  overwrite_mode = "OverwriteExisting"
+  source         = "${path.module}/dist/Acme.Config.1.0.0.zip"
+}
+
+resource "octopusdeploy_deployment_process" "example" {
+  project_id = "Projects-123"
+
+  step {
+    name = "Deploy Configuration"
+
+    deploy_package_action {
+      name = "Deploy Configuration"
+
+      primary_package {
+        package_id = octopusdeploy_package.example.package_id
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_polling_tentacle_worker.<name> <worker-id>
\ No newline at end of file
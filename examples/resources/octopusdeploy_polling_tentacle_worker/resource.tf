@@ -0,0 +1,7 @@
+resource "octopusdeploy_polling_tentacle_worker" "example" {
+  machine_policy_id = "MachinePolicies-1"
+  name              = "Polling Tentacle Worker (OK to Delete)"
+  tentacle_url      = "poll://abcdefghijklmnopqrst/"
+  thumbprint        = "[thumbprint]"
+  worker_pool_ids   = ["WorkerPools-123"]
+}
@@ -0,0 +1,47 @@
+resource "octopusdeploy_project_scheduled_trigger" "nightly" {
+  description = "Deploys a new release to the test environment every weeknight."
+  name        = "Nightly Release (OK to Delete)"
+  project_id  = "Projects-123"
+
+  cron_expression_schedule {
+    cron_expression = "0 0 22 * * Mon-Fri"
+    timezone        = "Australia/Brisbane"
+  }
+
+  deploy_new_release_action {
+    destination_environment_id = "Environments-123"
+  }
+}
+
+resource "octopusdeploy_project_scheduled_trigger" "promotion" {
+  name       = "Weekly Promotion (OK to Delete)"
+  project_id = "Projects-123"
+
+  once_daily_schedule {
+    days_of_week = ["Monday"]
+    start_time   = "2021-01-01T09:00:00Z"
+    timezone     = "UTC"
+  }
+
+  deploy_latest_release_action {
+    destination_environment_id = "Environments-321"
+    source_environment_ids     = ["Environments-123"]
+  }
+}
+
+resource "octopusdeploy_project_scheduled_trigger" "cleanup" {
+  name       = "Monthly Cleanup (OK to Delete)"
+  project_id = "Projects-123"
+
+  days_per_month_schedule {
+    day_number_of_month   = "L"
+    day_of_week           = "Sunday"
+    monthly_schedule_type = "DayOfMonth"
+    start_time            = "2021-01-01T03:00:00Z"
+  }
+
+  run_runbook_action {
+    runbook_id             = "Runbooks-123"
+    target_environment_ids = ["Environments-123", "Environments-321"]
+  }
+}
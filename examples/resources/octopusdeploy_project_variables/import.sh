@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_project_variables.<name> <owner-id>
\ No newline at end of file
@@ -0,0 +1,23 @@
+resource "octopusdeploy_project_variables" "example" {
+  owner_id = "Projects-123"
+
+  variable {
+    name  = "Greeting"
+    value = "Hello"
+  }
+
+  variable {
+    name  = "Greeting"
+    value = "Hello, production"
+
+    scope {
+      environments = ["Environments-123"]
+    }
+  }
+
+  variable {
+    name            = "DatabasePassword"
+    sensitive_value = "###########"
+    type            = "Sensitive"
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_release.<name> <release-id>
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "octopusdeploy_release" "example" {
+  channel_id    = "Channels-123"
+  project_id    = "Projects-123"
+  release_notes = "Created by Terraform"
+
+  # the latest version in the feed is selected for packages that are omitted
+  package {
+    action_name = "Deploy Web Site"
+    version     = "1.2.3"
+  }
+}
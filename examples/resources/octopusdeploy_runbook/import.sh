@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_runbook.<name> <runbook-id>
\ No newline at end of file
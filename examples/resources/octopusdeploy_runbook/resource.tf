@@ -0,0 +1,19 @@
+resource "octopusdeploy_runbook" "example" {
+  default_guided_failure_mode = "On"
+  description                 = "Restarts the web servers of the project."
+  environment_scope           = "Specified"
+  environments                = ["Environments-123", "Environments-321"]
+  multi_tenancy_mode          = "Untenanted"
+  name                        = "Restart Web Servers (OK to Delete)"
+  project_id                  = "Projects-123"
+
+  connectivity_policy {
+    allow_deployments_to_no_targets = false
+    exclude_unhealthy_targets       = true
+    skip_machine_behavior           = "SkipUnavailableMachines"
+  }
+
+  run_retention_policy {
+    quantity_to_keep = 50
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_runbook_process.<name> <runbook-process-id>
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "octopusdeploy_runbook_process" "example" {
+  runbook_id = "Runbooks-123"
+
+  step {
+    condition     = "Success"
+    name          = "Restart IIS"
+    start_trigger = "StartAfterPrevious"
+    target_roles  = ["web-server"]
+
+    run_script_action {
+      name          = "Restart IIS"
+      run_on_server = false
+      script_body   = "iisreset /restart"
+    }
+  }
+}
@@ -0,0 +1,5 @@
+resource "octopusdeploy_s3_feed" "example" {
+  access_key = "access-key"
+  name       = "Test S3 Feed (OK to Delete)"
+  secret_key = "secret-key"
+}
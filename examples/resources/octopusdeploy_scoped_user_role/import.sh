@@ -0,0 +1,5 @@
+# import by the ID of the scoped user role
+terraform import [options] octopusdeploy_scoped_user_role.<name> <scoped-user-role-id>
+
+# import by the IDs of the team and user role
+terraform import [options] octopusdeploy_scoped_user_role.<name> "<team-id>:<user-role-id>"
\ No newline at end of file
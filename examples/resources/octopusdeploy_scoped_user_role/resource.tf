@@ -0,0 +1,7 @@
+resource "octopusdeploy_scoped_user_role" "deployers" {
+  environment_ids = ["Environments-123", "Environments-321"]
+  project_ids     = ["Projects-123"]
+  space_id        = "Spaces-1"
+  team_id         = "Teams-123"
+  user_role_id    = "UserRoles-123"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_script_module.<name> <library-variable-set-id>
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "octopusdeploy_script_module" "greetings" {
+  description   = "Functions for greeting people."
+  name          = "Greetings (OK to Delete)"
+  script_syntax = "PowerShell"
+
+  script_body = <<-EOT
+    function Say-Hello($name) {
+      Write-Host "Hello, $name"
+    }
+  EOT
+}
+
+resource "octopusdeploy_project" "example" {
+  included_library_variable_sets = [octopusdeploy_script_module.greetings.id]
+  lifecycle_id                   = "Lifecycles-123"
+  name                           = "Example (OK to Delete)"
+  project_group_id               = "ProjectGroups-123"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_ssh_connection_worker.<name> <worker-id>
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "octopusdeploy_ssh_connection_worker" "example" {
+  account_id        = "Accounts-123"
+  fingerprint       = "[fingerprint]"
+  host              = "example.com"
+  machine_policy_id = "MachinePolicies-1"
+  name              = "SSH Connection Worker (OK to Delete)"
+  port              = 22
+  worker_pool_ids   = ["WorkerPools-123"]
+}
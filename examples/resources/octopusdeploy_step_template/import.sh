@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_step_template.<name> <action-template-id>
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "octopusdeploy_step_template" "greeting" {
+  action_type   = "Octopus.Script"
+  description   = "Writes a greeting to the task log."
+  name          = "Write Greeting (OK to Delete)"
+  script_body   = "Write-Host \"$($OctopusParameters['Greeting']), world\""
+  script_syntax = "PowerShell"
+
+  parameter {
+    default_value = "Hello"
+    help_text     = "The greeting to write to the task log."
+    label         = "Greeting"
+    name          = "Greeting"
+
+    display_settings = {
+      "Octopus.ControlType" = "SingleLineText"
+    }
+  }
+
+  properties = {
+    "Octopus.Action.RunOnServer" = "true"
+  }
+}
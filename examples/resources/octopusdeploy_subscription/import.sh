@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_subscription.<name> <subscription-id>
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "octopusdeploy_subscription" "production_failures" {
+  environment_ids  = ["Environments-123"]
+  event_categories = ["DeploymentFailed"]
+  name             = "Production deployment failures"
+
+  email {
+    digest_frequency = "30m"
+    priority         = "High"
+    team_ids         = ["Teams-123"]
+    timezone         = "Australia/Brisbane"
+  }
+
+  webhook {
+    header_key   = "Authorization"
+    header_value = "Bearer ###########"
+    timeout      = "30s"
+    uri          = "https://example.com/octopus/events"
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_tag.<name> <tag-set-id>:<tag-id>
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "octopusdeploy_tag_set" "customers" {
+  ignore_undeclared_tags = true
+  name                   = "Customers"
+}
+
+resource "octopusdeploy_tag" "acme" {
+  color       = "#00FF00"
+  description = "Acme Corporation"
+  name        = "Acme"
+  tag_set_id  = octopusdeploy_tag_set.customers.id
+}
+
+resource "octopusdeploy_tenant" "acme" {
+  name        = "Acme"
+  tenant_tags = [octopusdeploy_tag.acme.canonical_tag_name]
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_team_membership.<name> "<team-id>:<user-id>"
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "octopusdeploy_team_membership" "example" {
+  team_id = "Teams-123"
+  user_id = "Users-123"
+}
@@ -0,0 +1,6 @@
+resource "octopusdeploy_tenant_common_variable" "example" {
+  library_variable_set_id = "LibraryVariableSets-123"
+  template_id             = "7c2a1a2a-5b6e-4b8f-9f0e-1d2c3b4a5f6e"
+  tenant_id               = "Tenants-123"
+  value                   = "Password123!"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_tenant_project.<name> <tenant-id>:<project-id>
\ No newline at end of file
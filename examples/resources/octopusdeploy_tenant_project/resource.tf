@@ -0,0 +1,10 @@
+resource "octopusdeploy_tenant" "customer" {
+  ignore_project_environments = true
+  name                        = "Customer"
+}
+
+resource "octopusdeploy_tenant_project" "web" {
+  environment_ids = ["Environments-123", "Environments-321"]
+  project_id      = "Projects-123"
+  tenant_id       = octopusdeploy_tenant.customer.id
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_tenant_project_variable.<name> "<tenant-id>:<project-id>:<environment-id>:<template-id>"
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "octopusdeploy_tenant_project_variable" "example" {
+  environment_id = "Environments-123"
+  project_id     = "Projects-123"
+  template_id    = "0906031f-68ba-4a15-afaa-657c1564e07b"
+  tenant_id      = "Tenants-123"
+  value          = "https://tenant.example.com"
+}
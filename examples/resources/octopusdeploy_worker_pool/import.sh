@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_worker_pool.<name> <worker-pool-id>
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "octopusdeploy_worker_pool" "static" {
+  description = "A worker pool for self-hosted workers."
+  name        = "Static Worker Pool (OK to Delete)"
+}
+
+resource "octopusdeploy_worker_pool" "dynamic" {
+  description      = "A worker pool of dynamic workers provisioned by Octopus Cloud."
+  name             = "Dynamic Worker Pool (OK to Delete)"
+  worker_pool_type = "DynamicWorkerPool"
+  worker_type      = "UbuntuDefault"
+}
@@ -0,0 +1,120 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/dghubble/sling"
+)
+
+// The following functions issue requests against endpoints of the Octopus
+// API that are not exposed through the services of the client. They mirror
+// the request and error handling of the client so that callers can continue
+// to inspect *octopusdeploy.APIError (e.g. for 404 responses).
+
+func apiAdd(s *sling.Sling, path string, input interface{}, output interface{}) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Post(path).BodyJSON(input).Receive(output, octopusDeployError)
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusCreated, err, octopusDeployError)
+}
+
+func apiDelete(s *sling.Sling, path string) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Delete(path).Receive(nil, octopusDeployError)
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+func apiGet(s *sling.Sling, path string, output interface{}) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Get(path).Receive(output, octopusDeployError)
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+func apiPost(s *sling.Sling, path string, input interface{}, output interface{}) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Post(path).BodyJSON(input).Receive(output, octopusDeployError)
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+// errBadRequest is wrapped by apiUpload if the server rejects an upload (e.g.
+// because the package already exists).
+var errBadRequest = errors.New("the server rejected the request")
+
+// apiUpload posts a file as multipart form data.
+func apiUpload(s *sling.Sling, path string, fileName string, content io.Reader, output interface{}) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(part, content); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Post(path).Set("Content-Type", writer.FormDataContentType()).Body(body).Receive(output, octopusDeployError)
+	if err == nil && resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return fmt.Errorf("%w: %s", errBadRequest, octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError))
+	}
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+func apiUpdate(s *sling.Sling, path string, input interface{}, output interface{}) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Put(path).BodyJSON(input).Receive(output, octopusDeployError)
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+// errConcurrentModification is returned by apiUpdateVersioned if the server
+// rejects an update because the resource was modified since it was read.
+var errConcurrentModification = errors.New("the resource was modified concurrently")
+
+// apiUpdateVersioned updates a resource that carries the version with which
+// it was read. The status of the response is inspected directly since the
+// client does not report conflicts as *octopusdeploy.APIError.
+func apiUpdateVersioned(s *sling.Sling, path string, input interface{}, output interface{}) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := s.New().Put(path).BodyJSON(input).Receive(output, octopusDeployError)
+	if err == nil && resp != nil && resp.StatusCode == http.StatusConflict {
+		return errConcurrentModification
+	}
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+// getLinkPath returns the path of a link of the root resource (of the space
+// targeted by the client) without its URI template parameters. Links of
+// server-wide resources are read from the root resource of the server.
+func getLinkPath(client *octopusdeploy.Client, link string) (string, error) {
+	root, err := client.Root.Get()
+	if err != nil {
+		return "", err
+	}
+
+	path, ok := root.Links[link]
+	if !ok || len(path) == 0 {
+		serverRoot := octopusdeploy.NewRootResource()
+		if err := apiGet(client.Root.Sling, "/api", serverRoot); err != nil {
+			return "", err
+		}
+		path = serverRoot.Links[link]
+	}
+
+	if len(path) == 0 {
+		return "", fmt.Errorf("the Octopus server does not expose the %s link; it may not support this feature", link)
+	}
+
+	return strings.Split(path, "{")[0], nil
+}
@@ -11,7 +11,7 @@ import (
 
 func dataSourceAccounts() *schema.Resource {
 	return &schema.Resource{
-		DeprecationMessage: "Use an account-specific resource instead (i.e. octopusdeploy_aws_account, octopusdeploy_azure_service_principal, octopusdeploy_azure_subscription_account, octopusdeploy_ssh_key_account, octopusdeploy_token_account, octopusdeploy_username_password_account).",
+		DeprecationMessage: "Use an account-specific resource instead (e.g. octopusdeploy_aws_account, octopusdeploy_aws_openid_connect_account, octopusdeploy_azure_openid_connect, octopusdeploy_azure_service_principal, octopusdeploy_azure_subscription_account, octopusdeploy_gcp_account, octopusdeploy_ssh_key_account, octopusdeploy_token_account, octopusdeploy_username_password_account).",
 		Description:        "Provides information about existing accounts.",
 		ReadContext:        dataSourceAccountsRead,
 		Schema:             getAccountResourceDataSchema(),
@@ -28,18 +28,20 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	client := m.(*octopusdeploy.Client)
-	accounts, err := client.Accounts.Get(query)
+	path, err := client.Accounts.URITemplate.Expand(query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	flattenedAccounts := []interface{}{}
-	for _, account := range accounts.Items {
-		accountResource, err := octopusdeploy.ToAccountResource(account)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// the account resources are read as-is since the client cannot convert
+	// every account type (e.g. GoogleCloudAccount)
+	accounts := &octopusdeploy.AccountResources{}
+	if err := apiGet(client.Accounts.Sling, path, accounts); err != nil {
+		return diag.FromErr(err)
+	}
 
+	flattenedAccounts := []interface{}{}
+	for _, accountResource := range accounts.Items {
 		flattenedAccounts = append(flattenedAccounts, flattenAccountResource(accountResource))
 	}
 
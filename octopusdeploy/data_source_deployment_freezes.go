@@ -0,0 +1,105 @@
+package octopusdeploy
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDeploymentFreezes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing deployment freezes.",
+		ReadContext: dataSourceDeploymentFreezesRead,
+		Schema:      getDeploymentFreezeDataSchema(),
+	}
+}
+
+func dataSourceDeploymentFreezesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "DeploymentFreezes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query := url.Values{}
+	for parameter, key := range map[string]string{
+		"environmentIds": "environment_ids",
+		"ids":            "ids",
+		"projectIds":     "project_ids",
+		"tenantIds":      "tenant_ids",
+	} {
+		if values := getSliceFromTerraformTypeList(d.Get(key)); len(values) > 0 {
+			query.Set(parameter, strings.Join(values, ","))
+		}
+	}
+
+	if partialName := d.Get("partial_name").(string); len(partialName) > 0 {
+		query.Set("partialName", partialName)
+	}
+
+	if includeComplete := d.Get("include_complete").(bool); includeComplete {
+		query.Set("includeComplete", "true")
+	}
+
+	var activeAt *time.Time
+	if v, ok := d.GetOk("active_at"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		activeAt = &t
+	}
+
+	// all pages are read since whether a freeze is active can only be
+	// determined locally; skip and take are applied to the active freezes
+	matchingDeploymentFreezes := []*deploymentFreeze{}
+	for pageSkip := 0; ; {
+		query.Set("skip", strconv.Itoa(pageSkip))
+		query.Set("take", strconv.Itoa(deploymentFreezesPageSize))
+
+		freezes := &deploymentFreezes{}
+		if err := apiGet(client.Root.Sling, path+"?"+query.Encode(), freezes); err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, freeze := range freezes.DeploymentFreezes {
+			if activeAt != nil && !isDeploymentFreezeActive(freeze, *activeAt) {
+				continue
+			}
+
+			matchingDeploymentFreezes = append(matchingDeploymentFreezes, freeze)
+		}
+
+		pageSkip += len(freezes.DeploymentFreezes)
+		if len(freezes.DeploymentFreezes) == 0 || pageSkip >= freezes.Count {
+			break
+		}
+	}
+
+	skip := d.Get("skip").(int)
+	take := d.Get("take").(int)
+
+	flattenedDeploymentFreezes := []interface{}{}
+	for i, freeze := range matchingDeploymentFreezes {
+		if i < skip {
+			continue
+		}
+
+		if take > 0 && len(flattenedDeploymentFreezes) >= take {
+			break
+		}
+
+		flattenedDeploymentFreezes = append(flattenedDeploymentFreezes, flattenDeploymentFreeze(freeze))
+	}
+
+	d.Set("deployment_freezes", flattenedDeploymentFreezes)
+	d.SetId("DeploymentFreezes " + time.Now().UTC().String())
+
+	return nil
+}
@@ -27,18 +27,20 @@ func dataSourceFeedsRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	client := m.(*octopusdeploy.Client)
-	feeds, err := client.Feeds.Get(query)
+	path, err := client.Feeds.URITemplate.Expand(query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	flattenedFeeds := []interface{}{}
-	for _, feed := range feeds.Items {
-		feedResource, err := octopusdeploy.ToFeedResource(feed)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// the feed resources are read as-is since the client cannot convert every
+	// feed type (e.g. S3)
+	feeds := &octopusdeploy.FeedResources{}
+	if err := apiGet(client.Feeds.Sling, path, feeds); err != nil {
+		return diag.FromErr(err)
+	}
 
+	flattenedFeeds := []interface{}{}
+	for _, feedResource := range feeds.Items {
 		flattenedFeeds = append(flattenedFeeds, flattenFeed(feedResource))
 	}
 
@@ -0,0 +1,72 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFeeds(t *testing.T) {
+	dockerLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	dockerName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	nugetLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	nugetName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	dockerPrefix := "data.octopusdeploy_feeds." + dockerLocalName
+	nugetPrefix := "data.octopusdeploy_feeds." + nugetLocalName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testDockerContainerRegistryCheckDestroy,
+			testOctopusDeployNuGetFeedDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dockerPrefix, "feeds.#", "1"),
+					resource.TestCheckResourceAttrPair(dockerPrefix, "feeds.0.id", "octopusdeploy_docker_container_registry."+dockerLocalName, "id"),
+					resource.TestCheckResourceAttr(dockerPrefix, "feeds.0.api_version", "v2"),
+					resource.TestCheckResourceAttr(dockerPrefix, "feeds.0.feed_type", "Docker"),
+					resource.TestCheckResourceAttr(dockerPrefix, "feeds.0.feed_uri", "https://index.docker.io"),
+					resource.TestCheckResourceAttr(dockerPrefix, "feeds.0.name", dockerName),
+					resource.TestCheckResourceAttr(dockerPrefix, "feeds.0.registry_path", "testing"),
+					resource.TestCheckResourceAttr(nugetPrefix, "feeds.#", "1"),
+					resource.TestCheckResourceAttrPair(nugetPrefix, "feeds.0.id", "octopusdeploy_nuget_feed."+nugetLocalName, "id"),
+					resource.TestCheckResourceAttr(nugetPrefix, "feeds.0.feed_type", "NuGet"),
+					resource.TestCheckResourceAttr(nugetPrefix, "feeds.0.feed_uri", "http://test.com"),
+					resource.TestCheckResourceAttr(nugetPrefix, "feeds.0.is_enhanced_mode", "true"),
+					resource.TestCheckResourceAttr(nugetPrefix, "feeds.0.name", nugetName),
+				),
+				Config: testAccDataSourceFeedsConfig(dockerLocalName, dockerName, nugetLocalName, nugetName),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFeedsConfig(dockerLocalName string, dockerName string, nugetLocalName string, nugetName string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_docker_container_registry" "%[1]s" {
+		api_version   = "v2"
+		feed_uri      = "https://index.docker.io"
+		name          = "%[2]s"
+		registry_path = "testing"
+	}
+
+	resource "octopusdeploy_nuget_feed" "%[3]s" {
+		feed_uri         = "http://test.com"
+		is_enhanced_mode = true
+		name             = "%[4]s"
+	}
+
+	data "octopusdeploy_feeds" "%[1]s" {
+		feed_type    = "Docker"
+		partial_name = octopusdeploy_docker_container_registry.%[1]s.name
+	}
+
+	data "octopusdeploy_feeds" "%[3]s" {
+		feed_type    = "NuGet"
+		partial_name = octopusdeploy_nuget_feed.%[3]s.name
+	}`, dockerLocalName, dockerName, nugetLocalName, nugetName)
+}
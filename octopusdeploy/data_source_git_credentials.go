@@ -0,0 +1,67 @@
+package octopusdeploy
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGitCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing git credentials.",
+		ReadContext: dataSourceGitCredentialsRead,
+		Schema:      getGitCredentialDataSchema(),
+	}
+}
+
+func dataSourceGitCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "GitCredentials")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	query := url.Values{}
+	if len(name) > 0 {
+		query.Set("name", name)
+	}
+
+	if skip := d.Get("skip").(int); skip > 0 {
+		query.Set("skip", strconv.Itoa(skip))
+	}
+
+	if take := d.Get("take").(int); take > 0 {
+		query.Set("take", strconv.Itoa(take))
+	}
+
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	gitCredentials := &gitCredentials{}
+	if err := apiGet(client.Root.Sling, path, gitCredentials); err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedGitCredentials := []interface{}{}
+	for _, gitCredential := range gitCredentials.Items {
+		// the server matches names partially
+		if len(name) > 0 && gitCredential.Name != name {
+			continue
+		}
+
+		flattenedGitCredentials = append(flattenedGitCredentials, flattenGitCredential(gitCredential))
+	}
+
+	d.Set("git_credentials", flattenedGitCredentials)
+	d.SetId("GitCredentials " + time.Now().UTC().String())
+
+	return nil
+}
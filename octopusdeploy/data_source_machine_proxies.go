@@ -0,0 +1,61 @@
+package octopusdeploy
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMachineProxies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing machine proxies.",
+		ReadContext: dataSourceMachineProxiesRead,
+		Schema:      getMachineProxyDataSchema(),
+	}
+}
+
+func dataSourceMachineProxiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := url.Values{}
+	if ids := getSliceFromTerraformTypeList(d.Get("ids")); len(ids) > 0 {
+		query.Set("ids", strings.Join(ids, ","))
+	}
+
+	if partialName := d.Get("partial_name").(string); len(partialName) > 0 {
+		query.Set("partialName", partialName)
+	}
+
+	if skip := d.Get("skip").(int); skip > 0 {
+		query.Set("skip", strconv.Itoa(skip))
+	}
+
+	if take := d.Get("take").(int); take > 0 {
+		query.Set("take", strconv.Itoa(take))
+	}
+
+	client := m.(*octopusdeploy.Client)
+	path := client.Proxies.BasePath
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	proxies := &machineProxies{}
+	if err := apiGet(client.Proxies.Sling, path, proxies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedMachineProxies := []interface{}{}
+	for _, proxy := range proxies.Items {
+		flattenedMachineProxies = append(flattenedMachineProxies, flattenMachineProxy(proxy))
+	}
+
+	d.Set("machine_proxies", flattenedMachineProxies)
+	d.SetId("MachineProxies " + time.Now().UTC().String())
+
+	return nil
+}
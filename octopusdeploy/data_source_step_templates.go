@@ -0,0 +1,43 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceStepTemplates() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing step templates.",
+		ReadContext: dataSourceStepTemplatesRead,
+		Schema:      getStepTemplateDataSchema(),
+	}
+}
+
+func dataSourceStepTemplatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := octopusdeploy.ActionTemplatesQuery{
+		IDs:         expandArray(d.Get("ids").([]interface{})),
+		PartialName: d.Get("partial_name").(string),
+		Skip:        d.Get("skip").(int),
+		Take:        d.Get("take").(int),
+	}
+
+	client := m.(*octopusdeploy.Client)
+	stepTemplates, err := client.ActionTemplates.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedStepTemplates := []interface{}{}
+	for _, stepTemplate := range stepTemplates.Items {
+		flattenedStepTemplates = append(flattenedStepTemplates, flattenStepTemplate(stepTemplate))
+	}
+
+	d.Set("step_templates", flattenedStepTemplates)
+	d.SetId("StepTemplates " + time.Now().UTC().String())
+
+	return nil
+}
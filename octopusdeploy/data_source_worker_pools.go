@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceWorkerPools() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing worker pools.",
+		ReadContext: dataSourceWorkerPoolsRead,
+		Schema:      getWorkerPoolDataSchema(),
+	}
+}
+
+func dataSourceWorkerPoolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ids := expandArray(d.Get("ids").([]interface{}))
+	name := d.Get("name").(string)
+	partialName := d.Get("partial_name").(string)
+	skip := d.Get("skip").(int)
+	take := d.Get("take").(int)
+	workerPoolType := d.Get("worker_pool_type").(string)
+
+	// the worker pool service does not support queries; the filters are
+	// applied to the complete list of worker pools instead
+	client := m.(*octopusdeploy.Client)
+	workerPools, err := client.WorkerPools.GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matchingWorkerPools := []*octopusdeploy.WorkerPoolResource{}
+	for _, workerPool := range workerPools {
+		workerPoolResource := toWorkerPoolResource(workerPool)
+		if workerPoolResource == nil {
+			continue
+		}
+
+		if len(ids) > 0 && !validateStringInSlice(workerPoolResource.GetID(), ids) {
+			continue
+		}
+
+		if len(name) > 0 && !strings.EqualFold(workerPoolResource.Name, name) {
+			continue
+		}
+
+		if len(partialName) > 0 && !strings.Contains(strings.ToLower(workerPoolResource.Name), strings.ToLower(partialName)) {
+			continue
+		}
+
+		if len(workerPoolType) > 0 && string(workerPoolResource.WorkerPoolType) != workerPoolType {
+			continue
+		}
+
+		matchingWorkerPools = append(matchingWorkerPools, workerPoolResource)
+	}
+
+	flattenedWorkerPools := []interface{}{}
+	for i, workerPool := range matchingWorkerPools {
+		if i < skip {
+			continue
+		}
+
+		if take > 0 && len(flattenedWorkerPools) >= take {
+			break
+		}
+
+		flattenedWorkerPools = append(flattenedWorkerPools, flattenWorkerPool(workerPool))
+	}
+
+	d.Set("worker_pools", flattenedWorkerPools)
+	d.SetId("WorkerPools " + time.Now().UTC().String())
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package octopusdeploy
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceWorkers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing workers.",
+		ReadContext: dataSourceWorkersRead,
+		Schema:      getWorkerDataSchema(),
+	}
+}
+
+func dataSourceWorkersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	communicationStyles := expandArray(d.Get("communication_styles").([]interface{}))
+	healthStatuses := expandArray(d.Get("health_statuses").([]interface{}))
+	ids := expandArray(d.Get("ids").([]interface{}))
+	isDisabled := d.Get("is_disabled").(bool)
+	name := d.Get("name").(string)
+	partialName := d.Get("partial_name").(string)
+	skip := d.Get("skip").(int)
+	take := d.Get("take").(int)
+	workerPoolIDs := expandArray(d.Get("worker_pool_ids").([]interface{}))
+
+	// the worker service does not support queries; the filters are applied
+	// to the complete list of workers instead
+	client := m.(*octopusdeploy.Client)
+	workers, err := client.Workers.GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matchingWorkers := []*octopusdeploy.Worker{}
+	for _, worker := range workers {
+		if len(ids) > 0 && !validateStringInSlice(worker.GetID(), ids) {
+			continue
+		}
+
+		if len(name) > 0 && !strings.EqualFold(worker.Name, name) {
+			continue
+		}
+
+		if len(partialName) > 0 && !strings.Contains(strings.ToLower(worker.Name), strings.ToLower(partialName)) {
+			continue
+		}
+
+		if len(healthStatuses) > 0 && !validateStringInSlice(worker.HealthStatus, healthStatuses) {
+			continue
+		}
+
+		if isDisabled && !worker.IsDisabled {
+			continue
+		}
+
+		if len(communicationStyles) > 0 && (worker.Endpoint == nil || !validateStringInSlice(worker.Endpoint.GetCommunicationStyle(), communicationStyles)) {
+			continue
+		}
+
+		if len(workerPoolIDs) > 0 && !isWorkerInWorkerPools(worker, workerPoolIDs) {
+			continue
+		}
+
+		matchingWorkers = append(matchingWorkers, worker)
+	}
+
+	flattenedWorkers := []interface{}{}
+	for i, worker := range matchingWorkers {
+		if i < skip {
+			continue
+		}
+
+		if take > 0 && len(flattenedWorkers) >= take {
+			break
+		}
+
+		flattenedWorkers = append(flattenedWorkers, flattenWorker(worker))
+	}
+
+	d.Set("workers", flattenedWorkers)
+	d.SetId("Workers " + time.Now().UTC().String())
+
+	return nil
+}
+
+func isWorkerInWorkerPools(worker *octopusdeploy.Worker, workerPoolIDs []string) bool {
+	for _, workerPoolID := range worker.WorkerPoolIDs {
+		if validateStringInSlice(workerPoolID, workerPoolIDs) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -57,6 +57,34 @@ func TestAccOctopusDeployDeploymentProcessWithActionTemplate(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployDeploymentProcessWithOCIRegistryFeed(t *testing.T) {
+	feedLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	feedName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_deployment_process." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckOctopusDeployDeploymentProcessDestroy,
+			testOCIRegistryFeedCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "step.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "step.0.run_script_action.#", "1"),
+					resource.TestCheckResourceAttrPair(prefix, "step.0.run_script_action.0.primary_package.0.feed_id", "octopusdeploy_oci_registry_feed."+feedLocalName, "id"),
+					resource.TestCheckResourceAttr(prefix, "step.0.run_script_action.0.primary_package.0.package_id", "nginx"),
+					testAccCheckDeploymentProcessPackageFeed(prefix, "octopusdeploy_oci_registry_feed."+feedLocalName),
+				),
+				Config: testAccDeploymentProcessWithOCIRegistryFeed(feedLocalName, feedName, localName),
+			},
+		},
+	})
+}
+
 func testAccDeploymentProcessBasic() string {
 	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
@@ -193,6 +221,44 @@ func testAccBuildTestAction(action string) string {
 		}`, projectID, action)
 }
 
+func testAccDeploymentProcessWithOCIRegistryFeed(feedLocalName string, feedName string, localName string) string {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, description)+"\n"+
+		`resource "octopusdeploy_oci_registry_feed" "%s" {
+			feed_uri = "oci://registry-1.docker.io"
+			name     = "%s"
+		}
+
+		resource "octopusdeploy_deployment_process" "%s" {
+			project_id = octopusdeploy_project.%s.id
+
+			step {
+				name = "Test"
+				target_roles = ["WebServer"]
+
+				run_script_action {
+					name = "Test"
+					run_on_server = true
+					script_file_name = "Run.ps1"
+					script_source = "Package"
+
+					primary_package {
+						acquisition_location = "Server"
+						feed_id = octopusdeploy_oci_registry_feed.%s.id
+						package_id = "nginx"
+					}
+				}
+			}
+		}`, feedLocalName, feedName, localName, projectLocalName, feedLocalName)
+}
+
 func testAccCheckOctopusDeployDeploymentProcessDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*octopusdeploy.Client)
 
@@ -239,3 +305,27 @@ func getDeploymentProcess(s *terraform.State, client *octopusdeploy.Client) (*oc
 	}
 	return nil, fmt.Errorf("No deployment process found in the terraform resources")
 }
+
+func testAccCheckDeploymentProcessPackageFeed(prefix string, feedPrefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		processID := s.RootModule().Resources[prefix].Primary.ID
+		feedID := s.RootModule().Resources[feedPrefix].Primary.ID
+
+		process, err := client.DeploymentProcesses.GetByID(processID)
+		if err != nil {
+			return err
+		}
+
+		packages := process.Steps[0].Actions[0].Packages
+		if len(packages) != 1 {
+			return fmt.Errorf("deployment process has %d packages instead of the expected 1", len(packages))
+		}
+
+		if packages[0].FeedID != feedID {
+			return fmt.Errorf("package references feed %s instead of the expected %s", packages[0].FeedID, feedID)
+		}
+
+		return nil
+	}
+}
@@ -19,14 +19,17 @@ func Provider() *schema.Provider {
 			"octopusdeploy_certificates":                                    dataSourceCertificates(),
 			"octopusdeploy_cloud_region_deployment_targets":                 dataSourceCloudRegionDeploymentTargets(),
 			"octopusdeploy_channels":                                        dataSourceChannels(),
+			"octopusdeploy_deployment_freezes":                              dataSourceDeploymentFreezes(),
 			"octopusdeploy_deployment_targets":                              dataSourceDeploymentTargets(),
 			"octopusdeploy_environments":                                    dataSourceEnvironments(),
 			"octopusdeploy_feeds":                                           dataSourceFeeds(),
+			"octopusdeploy_git_credentials":                                 dataSourceGitCredentials(),
 			"octopusdeploy_kubernetes_cluster_deployment_targets":           dataSourceKubernetesClusterDeploymentTargets(),
 			"octopusdeploy_library_variable_sets":                           dataSourceLibraryVariableSet(),
 			"octopusdeploy_lifecycles":                                      dataSourceLifecycles(),
 			"octopusdeploy_listening_tentacle_deployment_targets":           dataSourceListeningTentacleDeploymentTargets(),
 			"octopusdeploy_machine":                                         dataMachine(),
+			"octopusdeploy_machine_proxies":                                 dataSourceMachineProxies(),
 			"octopusdeploy_machine_policies":                                dataSourceMachinePolicies(),
 			"octopusdeploy_offline_package_drop_deployment_targets":         dataSourceOfflinePackageDropDeploymentTargets(),
 			"octopusdeploy_polling_tentacle_deployment_targets":             dataSourcePollingTentacleDeploymentTargets(),
@@ -34,55 +37,94 @@ func Provider() *schema.Provider {
 			"octopusdeploy_projects":                                        dataSourceProjects(),
 			"octopusdeploy_spaces":                                          dataSourceSpaces(),
 			"octopusdeploy_ssh_connection_deployment_targets":               dataSourceSSHConnectionDeploymentTargets(),
+			"octopusdeploy_step_templates":                                  dataSourceStepTemplates(),
 			"octopusdeploy_tag_sets":                                        dataSourceTagSets(),
 			"octopusdeploy_teams":                                           dataSourceTeams(),
 			"octopusdeploy_tenants":                                         dataSourceTenants(),
 			"octopusdeploy_users":                                           dataSourceUsers(),
 			"octopusdeploy_user_roles":                                      dataSourceUserRoles(),
 			"octopusdeploy_variables":                                       dataSourceVariable(),
+			"octopusdeploy_worker_pools":                                    dataSourceWorkerPools(),
+			"octopusdeploy_workers":                                         dataSourceWorkers(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"octopusdeploy_account":                                        resourceAccount(),
+			"octopusdeploy_api_key":                                        resourceAPIKey(),
+			"octopusdeploy_artifactory_generic_feed":                       resourceArtifactoryGenericFeed(),
 			"octopusdeploy_aws_account":                                    resourceAmazonWebServicesAccount(),
+			"octopusdeploy_aws_openid_connect_account":                     resourceAmazonWebServicesOpenIDConnectAccount(),
 			"octopusdeploy_aws_elastic_container_registry":                 resourceAwsElasticContainerRegistry(),
 			"octopusdeploy_azure_cloud_service_deployment_target":          resourceAzureCloudServiceDeploymentTarget(),
+			"octopusdeploy_azure_openid_connect":                           resourceAzureOpenIDConnectAccount(),
 			"octopusdeploy_azure_service_fabric_cluster_deployment_target": resourceAzureServiceFabricClusterDeploymentTarget(),
 			"octopusdeploy_azure_service_principal":                        resourceAzureServicePrincipalAccount(),
 			"octopusdeploy_azure_subscription_account":                     resourceAzureSubscriptionAccount(),
 			"octopusdeploy_azure_web_app_deployment_target":                resourceAzureWebAppDeploymentTarget(),
+			"octopusdeploy_built_in_feed_retention":                        resourceBuiltInFeedRetention(),
 			"octopusdeploy_certificate":                                    resourceCertificate(),
 			"octopusdeploy_channel":                                        resourceChannel(),
 			"octopusdeploy_cloud_region_deployment_target":                 resourceCloudRegionDeploymentTarget(),
+			"octopusdeploy_community_step_template":                        resourceCommunityStepTemplate(),
+			"octopusdeploy_deployment":                                     resourceDeployment(),
+			"octopusdeploy_deployment_freeze":                              resourceDeploymentFreeze(),
 			"octopusdeploy_deployment_process":                             resourceDeploymentProcess(),
 			"octopusdeploy_deployment_target":                              resourceDeploymentTarget(),
 			"octopusdeploy_docker_container_registry":                      resourceDockerContainerRegistry(),
 			"octopusdeploy_environment":                                    resourceEnvironment(),
+			"octopusdeploy_event_retention":                                resourceEventRetention(),
 			"octopusdeploy_feed":                                           resourceFeed(),
+			"octopusdeploy_gcp_account":                                    resourceGoogleCloudAccount(),
+			"octopusdeploy_git_credential":                                 resourceGitCredential(),
 			"octopusdeploy_github_repository_feed":                         resourceGitHubRepositoryFeed(),
+			"octopusdeploy_google_container_registry":                      resourceGoogleContainerRegistry(),
 			"octopusdeploy_helm_feed":                                      resourceHelmFeed(),
 			"octopusdeploy_kubernetes_cluster_deployment_target":           resourceKubernetesClusterDeploymentTarget(),
 			"octopusdeploy_library_variable_set":                           resourceLibraryVariableSet(),
 			"octopusdeploy_lifecycle":                                      resourceLifecycle(),
 			"octopusdeploy_listening_tentacle_deployment_target":           resourceListeningTentacleDeploymentTarget(),
+			"octopusdeploy_listening_tentacle_worker":                      resourceListeningTentacleWorker(),
+			"octopusdeploy_maintenance_configuration":                      resourceMaintenanceConfiguration(),
 			"octopusdeploy_machine_policy":                                 resourceMachinePolicy(),
+			"octopusdeploy_machine_proxy":                                  resourceMachineProxy(),
 			"octopusdeploy_maven_feed":                                     resourceMavenFeed(),
 			"octopusdeploy_nuget_feed":                                     resourceNuGetFeed(),
+			"octopusdeploy_oci_registry_feed":                              resourceOCIRegistryFeed(),
 			"octopusdeploy_offline_package_drop_deployment_target":         resourceOfflinePackageDropDeploymentTarget(),
+			"octopusdeploy_package":                                        resourcePackage(),
 			"octopusdeploy_polling_tentacle_deployment_target":             resourcePollingTentacleDeploymentTarget(),
+			"octopusdeploy_polling_tentacle_worker":                        resourcePollingTentacleWorker(),
 			"octopusdeploy_project":                                        resourceProject(),
 			"octopusdeploy_project_deployment_target_trigger":              resourceProjectDeploymentTargetTrigger(),
 			"octopusdeploy_project_group":                                  resourceProjectGroup(),
+			"octopusdeploy_project_scheduled_trigger":                      resourceProjectScheduledTrigger(),
+			"octopusdeploy_project_variables":                              resourceProjectVariables(),
+			"octopusdeploy_release":                                        resourceRelease(),
+			"octopusdeploy_runbook":                                        resourceRunbook(),
+			"octopusdeploy_runbook_process":                                resourceRunbookProcess(),
+			"octopusdeploy_s3_feed":                                        resourceS3Feed(),
+			"octopusdeploy_scoped_user_role":                               resourceScopedUserRole(),
+			"octopusdeploy_script_module":                                  resourceScriptModule(),
+			"octopusdeploy_smtp_configuration":                             resourceSMTPConfiguration(),
 			"octopusdeploy_space":                                          resourceSpace(),
 			"octopusdeploy_ssh_connection_deployment_target":               resourceSSHConnectionDeploymentTarget(),
+			"octopusdeploy_ssh_connection_worker":                          resourceSSHConnectionWorker(),
 			"octopusdeploy_ssh_key_account":                                resourceSSHKeyAccount(),
+			"octopusdeploy_step_template":                                  resourceStepTemplate(),
+			"octopusdeploy_subscription":                                   resourceSubscription(),
+			"octopusdeploy_tag":                                            resourceTag(),
 			"octopusdeploy_tag_set":                                        resourceTagSet(),
 			"octopusdeploy_team":                                           resourceTeam(),
+			"octopusdeploy_team_membership":                                resourceTeamMembership(),
 			"octopusdeploy_tenant":                                         resourceTenant(),
+			"octopusdeploy_tenant_common_variable":                         resourceTenantCommonVariable(),
+			"octopusdeploy_tenant_project":                                 resourceTenantProject(),
+			"octopusdeploy_tenant_project_variable":                        resourceTenantProjectVariable(),
 			"octopusdeploy_token_account":                                  resourceTokenAccount(),
 			"octopusdeploy_user":                                           resourceUser(),
 			"octopusdeploy_user_role":                                      resourceUserRole(),
 			"octopusdeploy_username_password_account":                      resourceUsernamePasswordAccount(),
 			"octopusdeploy_variable":                                       resourceVariable(),
+			"octopusdeploy_worker_pool":                                    resourceWorkerPool(),
 		},
 		Schema: map[string]*schema.Schema{
 			"address": {
@@ -13,7 +13,7 @@ func resourceAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext:      resourceAccountCreate,
 		DeleteContext:      resourceAccountDelete,
-		DeprecationMessage: "Use an account-specific resource instead (i.e. octopusdeploy_aws_account, octopusdeploy_azure_service_principal, octopusdeploy_azure_subscription_account, octopusdeploy_ssh_key_account, octopusdeploy_token_account, octopusdeploy_username_password_account).",
+		DeprecationMessage: "Use an account-specific resource instead (e.g. octopusdeploy_aws_account, octopusdeploy_aws_openid_connect_account, octopusdeploy_azure_openid_connect, octopusdeploy_azure_service_principal, octopusdeploy_azure_subscription_account, octopusdeploy_gcp_account, octopusdeploy_ssh_key_account, octopusdeploy_token_account, octopusdeploy_username_password_account).",
 		Description:        "This resource manages accounts in Octopus Deploy.",
 		Importer:           getImporter(),
 		ReadContext:        resourceAccountRead,
@@ -28,21 +28,30 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, m interf
 	log.Printf("[INFO] creating account: %#v", accountResource)
 
 	client := m.(*octopusdeploy.Client)
-	createdAccount, err := client.Accounts.Add(accountResource)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	if isExtendedAccountType(accountResource.AccountType) {
+		createdAccount, err := addExtendedAccountResource(client, expandExtendedAccountResource(d, accountResource))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	accountResource, err = octopusdeploy.ToAccountResource(createdAccount)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		if err := setExtendedAccountResource(ctx, d, createdAccount); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		createdAccount, err := client.Accounts.Add(accountResource)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	if err := setAccountResource(ctx, d, accountResource); err != nil {
-		return diag.FromErr(err)
-	}
+		accountResource, err = octopusdeploy.ToAccountResource(createdAccount)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	d.SetId(accountResource.GetID())
+		if err := setAccountResource(ctx, d, accountResource); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	log.Printf("[INFO] account created (%s)", d.Id())
 	return nil
@@ -68,8 +77,8 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, m interfac
 	client := m.(*octopusdeploy.Client)
 	account, err := client.Accounts.GetByID(d.Id())
 	if err != nil {
-		apiError := err.(*octopusdeploy.APIError)
-		if apiError.StatusCode == 404 {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
 			log.Printf("[INFO] account (%s) not found; deleting from state", d.Id())
 			d.SetId("")
 			return nil
@@ -79,8 +88,21 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	accountResource := account.(*octopusdeploy.AccountResource)
 
-	if err := setAccountResource(ctx, d, accountResource); err != nil {
-		return diag.FromErr(err)
+	// the client drops the fields of the extended account types; these are
+	// read again as-is
+	if isExtendedAccountType(accountResource.AccountType) {
+		extendedAccount, err := getExtendedAccountResource(client, d.Id(), accountResource.AccountType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := setExtendedAccountResource(ctx, d, extendedAccount); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := setAccountResource(ctx, d, accountResource); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	log.Printf("[INFO] account read (%s)", d.Id())
@@ -92,15 +114,25 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	accountResource := expandAccountResource(d)
 	client := m.(*octopusdeploy.Client)
-	updatedAccount, err := client.Accounts.Update(accountResource)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 
-	updatedAccountResource := updatedAccount.(*octopusdeploy.AccountResource)
+	if isExtendedAccountType(accountResource.AccountType) {
+		updatedAccount, err := updateExtendedAccountResource(client, expandExtendedAccountResource(d, accountResource))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	if err := setAccountResource(ctx, d, updatedAccountResource); err != nil {
-		return diag.FromErr(err)
+		if err := setExtendedAccountResource(ctx, d, updatedAccount); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		updatedAccount, err := client.Accounts.Update(accountResource)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := setAccountResource(ctx, d, updatedAccount.(*octopusdeploy.AccountResource)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	log.Printf("[INFO] account updated (%s)", d.Id())
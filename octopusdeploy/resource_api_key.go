@@ -0,0 +1,106 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPIKeyCreate,
+		DeleteContext: resourceAPIKeyDelete,
+		Description:   "This resource manages the API keys of users (and service accounts) in Octopus Deploy. An API key that is revoked (or that expires) outside of Terraform is created again.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceAPIKeyImport},
+		ReadContext:   resourceAPIKeyRead,
+		Schema:        getAPIKeySchema(),
+	}
+}
+
+func resourceAPIKeyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing API key (%s)", d.Id())
+
+	importStrings := strings.Split(d.Id(), ":")
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_api_key import must be in the form of UserID:APIKeyID (e.g. Users-21:APIKeys-42)")
+	}
+
+	d.Set("user_id", importStrings[0])
+	d.SetId(importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiKey, err := expandAPIKey(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] creating API key for user (%s)", apiKey.UserID)
+
+	client := m.(*octopusdeploy.Client)
+	createdAPIKey := &userAPIKey{}
+	if err := apiPost(client.APIKeys.Sling, getAPIKeysPath(client, apiKey.UserID), apiKey, createdAPIKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAPIKey(ctx, d, createdAPIKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] API key created (%s)", d.Id())
+	return nil
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] revoking API key (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := apiDelete(client.APIKeys.Sling, getAPIKeysPath(client, d.Get("user_id").(string))+"/"+d.Id()); err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if !ok || apiError.StatusCode != 404 {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] API key revoked")
+	return nil
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading API key (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	apiKey, err := getAPIKey(client, d.Get("user_id").(string), d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] API key (%s) revoked; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if apiKey.Expires != nil && apiKey.Expires.Before(time.Now()) {
+		log.Printf("[INFO] API key (%s) expired; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := setAPIKey(ctx, d, apiKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] API key read (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,85 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAPIKeyBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_api_key." + localName
+
+	displayName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	emailAddress := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha) + "@example.com"
+	purpose := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	username := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	expires := time.Now().AddDate(0, 1, 0).UTC().Truncate(time.Second)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccAPIKeyCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAPIKeyExists(prefix),
+					resource.TestCheckResourceAttrSet(prefix, "api_key"),
+					resource.TestCheckResourceAttrSet(prefix, "created"),
+					resource.TestCheckResourceAttr(prefix, "purpose", purpose),
+					resource.TestCheckResourceAttrPair(prefix, "user_id", "octopusdeploy_user."+localName, "id"),
+				),
+				Config: testAccAPIKeyBasic(localName, displayName, emailAddress, username, purpose, expires),
+			},
+		},
+	})
+}
+
+func testAccAPIKeyBasic(localName string, displayName string, emailAddress string, username string, purpose string, expires time.Time) string {
+	return fmt.Sprintf(`resource "octopusdeploy_user" "%s" {
+		display_name  = "%s"
+		email_address = "%s"
+		is_active     = true
+		is_service    = true
+		username      = "%s"
+	}
+
+	resource "octopusdeploy_api_key" "%s" {
+		expires = "%s"
+		purpose = "%s"
+		user_id = octopusdeploy_user.%s.id
+	}`, localName, displayName, emailAddress, username, localName, expires.Format(time.RFC3339), purpose, localName)
+}
+
+func testAccAPIKeyExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		rs := s.RootModule().Resources[prefix]
+		if _, err := getAPIKey(client, rs.Primary.Attributes["user_id"], rs.Primary.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAPIKeyCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_api_key" {
+			continue
+		}
+
+		apiKey, err := getAPIKey(client, rs.Primary.Attributes["user_id"], rs.Primary.ID)
+		if err == nil && apiKey != nil {
+			return fmt.Errorf("API key (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
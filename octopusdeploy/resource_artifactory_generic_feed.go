@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceArtifactoryGenericFeed() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceArtifactoryGenericFeedCreate,
+		DeleteContext: resourceArtifactoryGenericFeedDelete,
+		Description:   "This resource manages an Artifactory generic feed in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceArtifactoryGenericFeedRead,
+		Schema:        getArtifactoryGenericFeedSchema(),
+		UpdateContext: resourceArtifactoryGenericFeedUpdate,
+	}
+}
+
+func resourceArtifactoryGenericFeedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandArtifactoryGenericFeed(d)
+
+	log.Printf("[INFO] creating Artifactory generic feed: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	createdFeed, err := addExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setArtifactoryGenericFeed(ctx, d, createdFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Artifactory generic feed created (%s)", d.Id())
+	return nil
+}
+
+func resourceArtifactoryGenericFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting Artifactory generic feed (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Feeds.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Artifactory generic feed deleted")
+	return nil
+}
+
+func resourceArtifactoryGenericFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading Artifactory generic feed (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	feed, err := getExtendedFeedResource(client, d.Id(), feedTypeArtifactoryGeneric)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] Artifactory generic feed (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setArtifactoryGenericFeed(ctx, d, feed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Artifactory generic feed read (%s)", d.Id())
+	return nil
+}
+
+func resourceArtifactoryGenericFeedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandArtifactoryGenericFeed(d)
+
+	log.Printf("[INFO] updating Artifactory generic feed: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	updatedFeed, err := updateExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setArtifactoryGenericFeed(ctx, d, updatedFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Artifactory generic feed updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAmazonWebServicesOpenIDConnectAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAmazonWebServicesOpenIDConnectAccountCreate,
+		DeleteContext: resourceAmazonWebServicesOpenIDConnectAccountDelete,
+		Description:   "This resource manages AWS OpenID Connect accounts in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceAmazonWebServicesOpenIDConnectAccountRead,
+		Schema:        getAmazonWebServicesOpenIDConnectAccountSchema(),
+		UpdateContext: resourceAmazonWebServicesOpenIDConnectAccountUpdate,
+	}
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandAmazonWebServicesOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] creating AWS OpenID Connect account: %#v", account)
+
+	client := m.(*octopusdeploy.Client)
+	createdAccount, err := addExtendedAccountResource(client, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAmazonWebServicesOpenIDConnectAccount(ctx, d, createdAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] AWS OpenID Connect account created (%s)", d.Id())
+	return nil
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting AWS OpenID Connect account (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] AWS OpenID Connect account deleted")
+	return nil
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading AWS OpenID Connect account (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	account, err := getExtendedAccountResource(client, d.Id(), accountTypeAmazonWebServicesOpenIDConnect)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] AWS OpenID Connect account (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setAmazonWebServicesOpenIDConnectAccount(ctx, d, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] AWS OpenID Connect account read (%s)", d.Id())
+	return nil
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandAmazonWebServicesOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] updating AWS OpenID Connect account: %#v", account)
+
+	client := m.(*octopusdeploy.Client)
+	updatedAccount, err := updateExtendedAccountResource(client, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAmazonWebServicesOpenIDConnectAccount(ctx, d, updatedAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] AWS OpenID Connect account updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSOpenIDConnectAccountBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_aws_openid_connect_account." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	roleArn := "arn:aws:iam::123456789012:role/" + acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "role_arn", roleArn),
+					resource.TestCheckResourceAttr(prefix, "session_duration", "3600"),
+				),
+				Config: testAccAWSOpenIDConnectAccountBasic(localName, name, roleArn, 3600, `["space", "environment"]`),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "deployment_subject_keys.#", "3"),
+					resource.TestCheckResourceAttr(prefix, "deployment_subject_keys.2", "runbook"),
+					resource.TestCheckResourceAttr(prefix, "session_duration", "7200"),
+				),
+				Config: testAccAWSOpenIDConnectAccountBasic(localName, name, roleArn, 7200, `["space", "environment", "runbook"]`),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func testAccAWSOpenIDConnectAccountBasic(localName string, name string, roleArn string, sessionDuration int, deploymentSubjectKeys string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_aws_openid_connect_account" "%s" {
+		deployment_subject_keys = %s
+		health_subject_keys     = ["space", "target"]
+		name                    = "%s"
+		role_arn                = "%s"
+		session_duration        = %d
+	}`, localName, deploymentSubjectKeys, name, roleArn, sessionDuration)
+}
@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAzureOpenIDConnectAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAzureOpenIDConnectAccountCreate,
+		DeleteContext: resourceAzureOpenIDConnectAccountDelete,
+		Description:   "This resource manages Azure OpenID Connect accounts in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceAzureOpenIDConnectAccountRead,
+		Schema:        getAzureOpenIDConnectAccountSchema(),
+		UpdateContext: resourceAzureOpenIDConnectAccountUpdate,
+	}
+}
+
+func resourceAzureOpenIDConnectAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandAzureOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] creating Azure OpenID Connect account: %#v", account)
+
+	client := m.(*octopusdeploy.Client)
+	createdAccount, err := addExtendedAccountResource(client, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAzureOpenIDConnectAccount(ctx, d, createdAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Azure OpenID Connect account created (%s)", d.Id())
+	return nil
+}
+
+func resourceAzureOpenIDConnectAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting Azure OpenID Connect account (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Azure OpenID Connect account deleted")
+	return nil
+}
+
+func resourceAzureOpenIDConnectAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading Azure OpenID Connect account (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	account, err := getExtendedAccountResource(client, d.Id(), accountTypeAzureOpenIDConnect)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] Azure OpenID Connect account (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setAzureOpenIDConnectAccount(ctx, d, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Azure OpenID Connect account read (%s)", d.Id())
+	return nil
+}
+
+func resourceAzureOpenIDConnectAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandAzureOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] updating Azure OpenID Connect account: %#v", account)
+
+	client := m.(*octopusdeploy.Client)
+	updatedAccount, err := updateExtendedAccountResource(client, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAzureOpenIDConnectAccount(ctx, d, updatedAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Azure OpenID Connect account updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,103 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	uuid "github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAzureOpenIDConnectAccountBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_azure_openid_connect." + localName
+
+	applicationID := uuid.New()
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	subscriptionID := uuid.New()
+	tenantID := uuid.New()
+
+	newDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "application_id", applicationID.String()),
+					resource.TestCheckResourceAttr(prefix, "audience", "api://AzureADTokenExchange"),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "health_subject_keys.#", "2"),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "subscription_id", subscriptionID.String()),
+					resource.TestCheckResourceAttr(prefix, "tenant_id", tenantID.String()),
+				),
+				Config: testAccAzureOpenIDConnectAccountBasic(localName, name, description, applicationID, tenantID, subscriptionID),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "description", newDescription),
+				),
+				Config: testAccAzureOpenIDConnectAccountBasic(localName, name, newDescription, applicationID, tenantID, subscriptionID),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func TestAccAccountAzureOpenIDConnect(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_account." + localName
+
+	applicationID := uuid.New()
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	subscriptionID := uuid.New()
+	tenantID := uuid.New()
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "account_type", "AzureOidc"),
+					resource.TestCheckResourceAttr(prefix, "deployment_subject_keys.#", "2"),
+					resource.TestCheckResourceAttr(prefix, "tenant_id", tenantID.String()),
+				),
+				Config: fmt.Sprintf(`resource "octopusdeploy_account" "%s" {
+					account_type            = "AzureOidc"
+					application_id          = "%s"
+					audience                = "api://AzureADTokenExchange"
+					deployment_subject_keys = ["space", "runbook"]
+					name                    = "%s"
+					subscription_id         = "%s"
+					tenant_id               = "%s"
+				}`, localName, applicationID, name, subscriptionID, tenantID),
+			},
+		},
+	})
+}
+
+func testAccAzureOpenIDConnectAccountBasic(localName string, name string, description string, applicationID uuid.UUID, tenantID uuid.UUID, subscriptionID uuid.UUID) string {
+	return fmt.Sprintf(`resource "octopusdeploy_azure_openid_connect" "%s" {
+		application_id          = "%s"
+		deployment_subject_keys = ["space", "environment", "project"]
+		description             = "%s"
+		health_subject_keys     = ["space", "target"]
+		name                    = "%s"
+		subscription_id         = "%s"
+		tenant_id               = "%s"
+	}`, localName, applicationID, description, name, subscriptionID, tenantID)
+}
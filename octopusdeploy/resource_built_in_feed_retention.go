@@ -0,0 +1,82 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBuiltInFeedRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBuiltInFeedRetentionCreate,
+		DeleteContext: resourceBuiltInFeedRetentionDelete,
+		Description:   "This resource manages the retention of unreleased packages in the built-in feed. The setting is a singleton; it is adopted on creation and reset to its default (packages are kept indefinitely) on deletion.",
+		Importer:      getImporter(),
+		ReadContext:   resourceBuiltInFeedRetentionRead,
+		Schema:        getBuiltInFeedRetentionSchema(),
+		UpdateContext: resourceBuiltInFeedRetentionUpdate,
+	}
+}
+
+func resourceBuiltInFeedRetentionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the built-in feed always exists; its retention is adopted and overwritten
+	log.Printf("[INFO] adopting built-in feed retention")
+
+	if err := resourceBuiltInFeedRetentionUpdate(ctx, d, m); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] built-in feed retention adopted (%s)", d.Id())
+	return nil
+}
+
+func resourceBuiltInFeedRetentionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] resetting built-in feed retention (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if _, err := updateBuiltInFeedRetention(client, 0); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] built-in feed retention reset")
+	return nil
+}
+
+func resourceBuiltInFeedRetentionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading built-in feed retention (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	feed, err := getBuiltInFeed(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setBuiltInFeedRetention(ctx, d, feed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] built-in feed retention read (%s)", d.Id())
+	return nil
+}
+
+func resourceBuiltInFeedRetentionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating built-in feed retention")
+
+	client := m.(*octopusdeploy.Client)
+	updatedFeed, err := updateBuiltInFeedRetention(client, d.Get("delete_unreleased_packages_after_days").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setBuiltInFeedRetention(ctx, d, updatedFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] built-in feed retention updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBuiltInFeedRetentionBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_built_in_feed_retention." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccBuiltInFeedRetentionCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check:  resource.TestCheckResourceAttr(prefix, "delete_unreleased_packages_after_days", "30"),
+				Config: testAccBuiltInFeedRetentionBasic(localName, 30),
+			},
+			{
+				Check:  resource.TestCheckResourceAttr(prefix, "delete_unreleased_packages_after_days", "7"),
+				Config: testAccBuiltInFeedRetentionBasic(localName, 7),
+			},
+		},
+	})
+}
+
+func testAccBuiltInFeedRetentionBasic(localName string, days int) string {
+	return fmt.Sprintf(`resource "octopusdeploy_built_in_feed_retention" "%s" {
+		delete_unreleased_packages_after_days = %d
+	}`, localName, days)
+}
+
+func testAccBuiltInFeedRetentionCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	feed, err := getBuiltInFeed(client)
+	if err != nil {
+		return err
+	}
+
+	if days, ok := feed["DeleteUnreleasedPackagesAfterDays"]; ok && days != nil {
+		return fmt.Errorf("built-in feed retention (%v days) was not reset", days)
+	}
+
+	return nil
+}
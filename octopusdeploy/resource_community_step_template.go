@@ -0,0 +1,135 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCommunityStepTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCommunityStepTemplateCreate,
+		DeleteContext: resourceCommunityStepTemplateDelete,
+		Description:   "This resource manages the installation of community step templates in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceCommunityStepTemplateRead,
+		Schema:        getCommunityStepTemplateSchema(),
+		UpdateContext: resourceCommunityStepTemplateUpdate,
+	}
+}
+
+func resourceCommunityStepTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	communityActionTemplateID := d.Get("community_action_template_id").(string)
+
+	log.Printf("[INFO] installing community step template (%s)", communityActionTemplateID)
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getCommunityStepTemplateInstallationPath(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	installedStepTemplate := &octopusdeploy.ActionTemplate{}
+	if err := apiPost(client.CommunityActionTemplates.Sling, path, nil, installedStepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setCommunityStepTemplate(ctx, d, installedStepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] community step template installed (%s)", d.Id())
+	return nil
+}
+
+func resourceCommunityStepTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting community step template (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.ActionTemplates.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] community step template deleted")
+	return nil
+}
+
+func resourceCommunityStepTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading community step template (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	stepTemplate, err := client.ActionTemplates.GetByID(d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] community step template (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setCommunityStepTemplate(ctx, d, stepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] community step template read (%s)", d.Id())
+	return nil
+}
+
+func resourceCommunityStepTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating community step template (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getCommunityStepTemplateInstallationPath(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedStepTemplate := &octopusdeploy.ActionTemplate{}
+	if err := apiUpdate(client.CommunityActionTemplates.Sling, path, nil, updatedStepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setCommunityStepTemplate(ctx, d, updatedStepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] community step template updated (%s)", d.Id())
+	return nil
+}
+
+// getCommunityStepTemplateInstallationPath returns the path through which
+// the community step template is installed or updated. Since only the latest
+// version of a community step template can be installed, an error is
+// returned if the requested version is not the latest version.
+func getCommunityStepTemplateInstallationPath(client *octopusdeploy.Client, d *schema.ResourceData) (string, error) {
+	communityActionTemplateID := d.Get("community_action_template_id").(string)
+
+	communityActionTemplate, err := client.CommunityActionTemplates.GetByID(communityActionTemplateID)
+	if err != nil {
+		return "", err
+	}
+
+	if v, ok := d.GetOk("version"); ok && d.HasChange("version") && int32(v.(int)) != communityActionTemplate.Version {
+		return "", fmt.Errorf("version %d of community step template (%s) cannot be installed; the latest version is %d", v.(int), communityActionTemplateID, communityActionTemplate.Version)
+	}
+
+	path, err := client.CommunityActionTemplates.URITemplate.Expand(map[string]interface{}{"id": communityActionTemplateID})
+	if err != nil {
+		return "", err
+	}
+
+	path += "/installation"
+	if v, ok := d.GetOk("space_id"); ok {
+		path += "/" + v.(string)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,82 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCommunityStepTemplateBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_community_step_template." + localName
+
+	// the first community step template of the library; every installation
+	// of Octopus Deploy that can reach the library has access to it
+	communityActionTemplateID := "CommunityActionTemplates-1"
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCommunityStepTemplateCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCommunityStepTemplateExists(prefix),
+					resource.TestCheckResourceAttrPair(prefix, "action_template_id", prefix, "id"),
+					resource.TestCheckResourceAttr(prefix, "community_action_template_id", communityActionTemplateID),
+					resource.TestCheckResourceAttrSet(prefix, "name"),
+					resource.TestCheckResourceAttrSet(prefix, "version"),
+				),
+				Config: testAccCommunityStepTemplateBasic(localName, communityActionTemplateID),
+			},
+			{
+				ResourceName:      prefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCommunityStepTemplateBasic(localName string, communityActionTemplateID string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_community_step_template" "%s" {
+		community_action_template_id = "%s"
+	}`, localName, communityActionTemplateID)
+}
+
+func testAccCommunityStepTemplateExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		stepTemplateID := s.RootModule().Resources[prefix].Primary.ID
+		stepTemplate, err := client.ActionTemplates.GetByID(stepTemplateID)
+		if err != nil {
+			return err
+		}
+
+		if len(stepTemplate.CommunityActionTemplateID) == 0 {
+			return fmt.Errorf("step template (%s) was not installed from a community step template", stepTemplateID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCommunityStepTemplateCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_community_step_template" {
+			continue
+		}
+
+		stepTemplate, err := client.ActionTemplates.GetByID(rs.Primary.ID)
+		if err == nil && stepTemplate != nil {
+			return fmt.Errorf("community step template (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
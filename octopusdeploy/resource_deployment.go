@@ -0,0 +1,125 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentCreate,
+		DeleteContext: resourceDeploymentDelete,
+		Description:   "This resource deploys a release to an environment in Octopus Deploy and waits for the deployment to complete.",
+		Importer:      getImporter(),
+		ReadContext:   resourceDeploymentRead,
+		Schema:        getDeploymentSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	deployment := expandDeployment(d)
+
+	client := m.(*octopusdeploy.Client)
+	preview, err := getDeploymentPreview(client, deployment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployment.FormValues, err = expandDeploymentFormValues(d.Get("form_values").(map[string]interface{}), preview.Form)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] creating deployment of release (%s) to environment (%s)", *deployment.ReleaseID, *deployment.EnvironmentID)
+
+	createdDeployment, err := client.Deployments.Add(deployment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setDeployment(ctx, d, createdDeployment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] deployment created (%s); waiting for task (%s) to complete", d.Id(), createdDeployment.TaskID)
+
+	stateConf := &resource.StateChangeConf{
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Pending:    []string{"Cancelling", "Executing", "Queued"},
+		Refresh:    refreshServerTask(client, createdDeployment.TaskID),
+		Target:     []string{"Success"},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("deployment (%s) did not complete successfully: %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] deployment completed (%s)", d.Id())
+	return nil
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// a deployment cannot be undone; it is only removed from the state so that
+	// its history is retained by Octopus Deploy
+	log.Printf("[INFO] deployment (%s) retained; removing from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading deployment (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	deployment, err := client.Deployments.GetByID(d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] deployment (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setDeployment(ctx, d, deployment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] deployment read (%s)", d.Id())
+	return nil
+}
+
+// refreshServerTask returns the state of a server task. An error that includes
+// the tail of the task log is returned once the task has failed.
+func refreshServerTask(client *octopusdeploy.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := getServerTask(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if task.IsCompleted && !task.FinishedSuccessfully {
+			logTail, err := getServerTaskLogTail(client, id, serverTaskLogTailLines)
+			if err != nil {
+				log.Printf("[WARN] unable to read the log of task (%s): %s", id, err)
+			}
+
+			return task, task.State, fmt.Errorf("task (%s) finished with state %s: %s\n%s", id, task.State, task.ErrorMessage, logTail)
+		}
+
+		return task, task.State, nil
+	}
+}
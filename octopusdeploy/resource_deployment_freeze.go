@@ -0,0 +1,118 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDeploymentFreeze() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentFreezeCreate,
+		DeleteContext: resourceDeploymentFreezeDelete,
+		Description:   "This resource manages deployment freezes in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceDeploymentFreezeRead,
+		Schema:        getDeploymentFreezeSchema(),
+		UpdateContext: resourceDeploymentFreezeUpdate,
+	}
+}
+
+func resourceDeploymentFreezeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	freeze, err := expandDeploymentFreeze(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] creating deployment freeze: %s", freeze.Name)
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "DeploymentFreezes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdDeploymentFreeze := &deploymentFreeze{}
+	if err := apiPost(client.Root.Sling, path, freeze, createdDeploymentFreeze); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setDeploymentFreeze(ctx, d, createdDeploymentFreeze); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] deployment freeze created (%s)", d.Id())
+	return nil
+}
+
+func resourceDeploymentFreezeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting deployment freeze (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "DeploymentFreezes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := apiDelete(client.Root.Sling, path+"/"+d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] deployment freeze deleted")
+	return nil
+}
+
+func resourceDeploymentFreezeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading deployment freeze (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	freeze, err := getDeploymentFreeze(client, d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] deployment freeze (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setDeploymentFreeze(ctx, d, freeze); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] deployment freeze read (%s)", d.Id())
+	return nil
+}
+
+func resourceDeploymentFreezeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating deployment freeze (%s)", d.Id())
+
+	freeze, err := expandDeploymentFreeze(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "DeploymentFreezes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedDeploymentFreeze := &deploymentFreeze{}
+	if err := apiUpdate(client.Root.Sling, path+"/"+d.Id(), freeze, updatedDeploymentFreeze); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setDeploymentFreeze(ctx, d, updatedDeploymentFreeze); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] deployment freeze updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,159 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDeploymentFreezeBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_deployment_freeze." + localName
+
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	start := time.Now().AddDate(0, 0, 1).Truncate(time.Hour)
+	end := start.Add(48 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccDeploymentFreezeCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccDeploymentFreezeExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "project.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "recurring_schedule.#", "0"),
+				),
+				Config: testAccDeploymentFreezeBasic(localName, environmentName, lifecycleName, projectGroupName, projectName, name, start, end),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccDeploymentFreezeExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "name", newName),
+					resource.TestCheckResourceAttr("data.octopusdeploy_deployment_freezes."+localName, "deployment_freezes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_deployment_freezes."+localName, "deployment_freezes.0.id", prefix, "id"),
+				),
+				Config: testAccDeploymentFreezeBasic(localName, environmentName, lifecycleName, projectGroupName, projectName, newName, start, end) + "\n" +
+					fmt.Sprintf(`data "octopusdeploy_deployment_freezes" "%s" {
+						active_at = "%s"
+						ids       = [octopusdeploy_deployment_freeze.%s.id]
+					}`, localName, start.Add(time.Hour).Format(time.RFC3339), localName),
+			},
+		},
+	})
+}
+
+func TestIsDeploymentFreezeActive(t *testing.T) {
+	location := time.FixedZone("AEST", 10*60*60)
+	start := time.Date(2021, time.December, 24, 18, 0, 0, 0, location)
+	freeze := &deploymentFreeze{
+		End:   start.Add(3 * 24 * time.Hour),
+		Start: start,
+	}
+
+	require.False(t, isDeploymentFreezeActive(freeze, start.Add(-time.Minute)))
+	require.True(t, isDeploymentFreezeActive(freeze, start))
+	require.True(t, isDeploymentFreezeActive(freeze, start.Add(48*time.Hour).UTC()))
+	require.False(t, isDeploymentFreezeActive(freeze, freeze.End))
+
+	freeze.RecurringSchedule = &deploymentFreezeSchedule{
+		EndAfterOccurrences: 2,
+		EndType:             "AfterOccurrences",
+		Type:                "Annually",
+		Unit:                1,
+	}
+
+	require.True(t, isDeploymentFreezeActive(freeze, start.AddDate(1, 0, 1)))
+	require.False(t, isDeploymentFreezeActive(freeze, start.AddDate(1, 0, 4)))
+	require.False(t, isDeploymentFreezeActive(freeze, start.AddDate(2, 0, 1)))
+
+	// every second Friday from 18:00 to 20:00
+	weekly := &deploymentFreeze{
+		End: start.Add(2 * time.Hour),
+		RecurringSchedule: &deploymentFreezeSchedule{
+			DaysOfWeek: []string{"Friday"},
+			EndType:    "Never",
+			Type:       "Weekly",
+			Unit:       2,
+		},
+		Start: start,
+	}
+
+	require.True(t, isDeploymentFreezeActive(weekly, start.AddDate(0, 0, 14).Add(time.Hour)))
+	require.False(t, isDeploymentFreezeActive(weekly, start.AddDate(0, 0, 7).Add(time.Hour)))
+	require.False(t, isDeploymentFreezeActive(weekly, start.AddDate(0, 0, 14).Add(3*time.Hour)))
+
+	// the last Monday of every month from 18:00 to 20:00
+	monthly := &deploymentFreeze{
+		End: start.Add(2 * time.Hour),
+		RecurringSchedule: &deploymentFreezeSchedule{
+			DayNumberOfMonth:    "L",
+			DayOfWeek:           "Monday",
+			EndType:             "Never",
+			MonthlyScheduleType: "DayOfMonth",
+			Type:                "Monthly",
+			Unit:                1,
+		},
+		Start: start,
+	}
+
+	require.True(t, isDeploymentFreezeActive(monthly, time.Date(2022, time.January, 31, 19, 0, 0, 0, location)))
+	require.False(t, isDeploymentFreezeActive(monthly, time.Date(2022, time.January, 24, 19, 0, 0, 0, location)))
+}
+
+func testAccDeploymentFreezeBasic(localName string, environmentName string, lifecycleName string, projectGroupName string, projectName string, name string, start time.Time, end time.Time) string {
+	return fmt.Sprintf(testAccProjectBasic(localName, lifecycleName, localName, projectGroupName, localName, projectName, "")+"\n"+
+		testEnvironmentMinimum(localName, environmentName)+"\n"+
+		`resource "octopusdeploy_deployment_freeze" "%s" {
+			end   = "%s"
+			name  = "%s"
+			start = "%s"
+
+			project {
+				environment_ids = [octopusdeploy_environment.%s.id]
+				project_id      = octopusdeploy_project.%s.id
+			}
+		}`, localName, end.Format(time.RFC3339), name, start.Format(time.RFC3339), localName, localName)
+}
+
+func testAccDeploymentFreezeExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		freezeID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getDeploymentFreeze(client, freezeID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccDeploymentFreezeCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_deployment_freeze" {
+			continue
+		}
+
+		freeze, err := getDeploymentFreeze(client, rs.Primary.ID)
+		if err == nil && freeze != nil {
+			return fmt.Errorf("deployment freeze (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDeploymentBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_deployment." + localName
+
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccDeploymentExists(prefix),
+					resource.TestCheckResourceAttrPair(prefix, "environment_id", "octopusdeploy_environment."+localName, "id"),
+					resource.TestCheckResourceAttrPair(prefix, "project_id", "octopusdeploy_project."+localName, "id"),
+					resource.TestCheckResourceAttrPair(prefix, "release_id", "octopusdeploy_release."+localName, "id"),
+					resource.TestCheckResourceAttrSet(prefix, "task_id"),
+				),
+				Config: testAccDeploymentBasic(localName, environmentName, lifecycleName, projectGroupName, projectName),
+			},
+		},
+	})
+}
+
+func TestExpandDeploymentFormValues(t *testing.T) {
+	form := &deploymentForm{
+		Elements: []*deploymentFormElement{
+			{Control: &deploymentFormControl{Name: "Optional"}, Name: "Variables-1"},
+			{Control: &deploymentFormControl{Name: "Required", Required: true}, Name: "Variables-2"},
+		},
+	}
+
+	values, err := expandDeploymentFormValues(map[string]interface{}{"Required": "value"}, form)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Variables-2": "value"}, values)
+
+	_, err = expandDeploymentFormValues(map[string]interface{}{"Optional": "value"}, form)
+	require.Error(t, err)
+
+	_, err = expandDeploymentFormValues(map[string]interface{}{"Required": "value", "Unknown": "value"}, form)
+	require.Error(t, err)
+}
+
+func testAccDeploymentBasic(localName string, environmentName string, lifecycleName string, projectGroupName string, projectName string) string {
+	return fmt.Sprintf(testEnvironmentMinimum(localName, environmentName)+"\n"+
+		testAccReleaseBasic(localName, lifecycleName, projectGroupName, projectName, "")+"\n"+
+		`resource "octopusdeploy_deployment" "%s" {
+			comments       = "Deployed by Terraform"
+			environment_id = octopusdeploy_environment.%s.id
+			release_id     = octopusdeploy_release.%s.id
+		}`, localName, localName, localName)
+}
+
+func testAccDeploymentExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		deploymentID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.Deployments.GetByID(deploymentID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
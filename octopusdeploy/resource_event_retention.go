@@ -0,0 +1,83 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEventRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEventRetentionCreate,
+		DeleteContext: resourceEventRetentionDelete,
+		Description:   "This resource manages the retention of the events (audit log) of the Octopus server. The settings are a singleton; they are adopted on creation and reset to their defaults on deletion.",
+		Importer:      getImporter(),
+		ReadContext:   resourceEventRetentionRead,
+		Schema:        getEventRetentionSchema(),
+		UpdateContext: resourceEventRetentionUpdate,
+	}
+}
+
+func resourceEventRetentionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the event retention configuration always exists; it is adopted and
+	// overwritten
+	log.Printf("[INFO] adopting event retention")
+
+	if err := resourceEventRetentionUpdate(ctx, d, m); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] event retention adopted (%s)", d.Id())
+	return nil
+}
+
+func resourceEventRetentionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] resetting event retention (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if _, err := updateEventRetention(client, defaultEventRetentionDays, defaultArchivedEventRetentionDays); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] event retention reset")
+	return nil
+}
+
+func resourceEventRetentionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading event retention (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	values, err := getConfigurationValues(client, eventRetentionConfigurationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setEventRetention(ctx, d, values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] event retention read (%s)", d.Id())
+	return nil
+}
+
+func resourceEventRetentionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating event retention")
+
+	client := m.(*octopusdeploy.Client)
+	updatedValues, err := updateEventRetention(client, d.Get("event_retention_days").(int), d.Get("archived_event_retention_days").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setEventRetention(ctx, d, updatedValues); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] event retention updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccEventRetentionBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_event_retention." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccEventRetentionCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "archived_event_retention_days", "400"),
+					resource.TestCheckResourceAttr(prefix, "event_retention_days", "120"),
+				),
+				Config: testAccEventRetentionBasic(localName, 120, 400),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "event_retention_days", "60"),
+				),
+				Config: testAccEventRetentionBasic(localName, 60, 400),
+			},
+		},
+	})
+}
+
+func testAccEventRetentionBasic(localName string, eventRetentionDays int, archivedEventRetentionDays int) string {
+	return fmt.Sprintf(`resource "octopusdeploy_event_retention" "%s" {
+		archived_event_retention_days = %d
+		event_retention_days          = %d
+	}`, localName, archivedEventRetentionDays, eventRetentionDays)
+}
+
+func testAccEventRetentionCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	values, err := getConfigurationValues(client, eventRetentionConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	if days, ok := values["EventRetentionDays"].(float64); !ok || int(days) != defaultEventRetentionDays {
+		return fmt.Errorf("event retention (%v days) was not reset", values["EventRetentionDays"])
+	}
+
+	return nil
+}
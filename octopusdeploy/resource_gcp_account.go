@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGoogleCloudAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGoogleCloudAccountCreate,
+		DeleteContext: resourceGoogleCloudAccountDelete,
+		Description:   "This resource manages Google Cloud accounts in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceGoogleCloudAccountRead,
+		Schema:        getGoogleCloudAccountSchema(),
+		UpdateContext: resourceGoogleCloudAccountUpdate,
+	}
+}
+
+func resourceGoogleCloudAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandGoogleCloudAccount(d)
+
+	log.Printf("[INFO] creating Google Cloud account: %#v", account)
+
+	client := m.(*octopusdeploy.Client)
+	createdAccount, err := addExtendedAccountResource(client, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleCloudAccount(ctx, d, createdAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Google Cloud account created (%s)", d.Id())
+	return nil
+}
+
+func resourceGoogleCloudAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting Google Cloud account (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Google Cloud account deleted")
+	return nil
+}
+
+func resourceGoogleCloudAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading Google Cloud account (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	account, err := getExtendedAccountResource(client, d.Id(), accountTypeGoogleCloudAccount)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] Google Cloud account (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleCloudAccount(ctx, d, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Google Cloud account read (%s)", d.Id())
+	return nil
+}
+
+func resourceGoogleCloudAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandGoogleCloudAccount(d)
+
+	log.Printf("[INFO] updating Google Cloud account: %#v", account)
+
+	client := m.(*octopusdeploy.Client)
+	updatedAccount, err := updateExtendedAccountResource(client, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleCloudAccount(ctx, d, updatedAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Google Cloud account updated (%s)", d.Id())
+	return nil
+}
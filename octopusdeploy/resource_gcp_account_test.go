@@ -0,0 +1,125 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGoogleCloudAccountBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_gcp_account." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	jsonKey := fmt.Sprintf(`{\"type\": \"service_account\", \"private_key_id\": \"%s\"}`, acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum))
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantedDeploymentParticipation := octopusdeploy.TenantedDeploymentModeTenantedOrUntenanted
+
+	newDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccGoogleCloudAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttrSet(prefix, "json_key"),
+					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentParticipation)),
+					resource.TestCheckResourceAttr("data.octopusdeploy_accounts."+localName, "accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_accounts."+localName, "accounts.0.account_type", "GoogleCloudAccount"),
+				),
+				Config: testAccGoogleCloudAccountBasic(localName, name, description, jsonKey, tenantedDeploymentParticipation),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "description", newDescription),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+				),
+				Config: testAccGoogleCloudAccountBasic(localName, name, newDescription, jsonKey, tenantedDeploymentParticipation),
+			},
+			{
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"json_key"},
+				ResourceName:            prefix,
+			},
+		},
+	})
+}
+
+func TestAccGoogleCloudAccountVariable(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_variable." + localName
+
+	accountName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	jsonKey := fmt.Sprintf(`{\"type\": \"service_account\", \"private_key_id\": \"%s\"}`, acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum))
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	variableName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccGoogleCloudAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "type", "GoogleCloudAccount"),
+					resource.TestCheckResourceAttrPair(prefix, "value", "octopusdeploy_gcp_account."+localName, "id"),
+				),
+				Config: fmt.Sprintf(testAccProjectBasic(localName, lifecycleName, localName, projectGroupName, localName, projectName, "")+"\n"+
+					`resource "octopusdeploy_gcp_account" "%s" {
+						json_key = "%s"
+						name     = "%s"
+					}
+
+					resource "octopusdeploy_variable" "%s" {
+						name     = "%s"
+						owner_id = octopusdeploy_project.%s.id
+						type     = "GoogleCloudAccount"
+						value    = octopusdeploy_gcp_account.%s.id
+					}`, localName, jsonKey, accountName, localName, variableName, localName, localName),
+			},
+		},
+	})
+}
+
+func testAccGoogleCloudAccountBasic(localName string, name string, description string, jsonKey string, tenantedDeploymentParticipation octopusdeploy.TenantedDeploymentMode) string {
+	return fmt.Sprintf(`resource "octopusdeploy_gcp_account" "%s" {
+		description                       = "%s"
+		json_key                          = "%s"
+		name                              = "%s"
+		tenanted_deployment_participation = "%s"
+	}
+
+	data "octopusdeploy_accounts" "%s" {
+		account_type = "GoogleCloudAccount"
+		ids          = [octopusdeploy_gcp_account.%s.id]
+	}`, localName, description, jsonKey, name, tenantedDeploymentParticipation, localName, localName)
+}
+
+func testAccGoogleCloudAccountCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_gcp_account" {
+			continue
+		}
+
+		account, err := getExtendedAccountResource(client, rs.Primary.ID, accountTypeGoogleCloudAccount)
+		if err == nil && account != nil {
+			return fmt.Errorf("Google Cloud account (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
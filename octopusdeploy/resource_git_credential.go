@@ -0,0 +1,118 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGitCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitCredentialCreate,
+		DeleteContext: resourceGitCredentialDelete,
+		Description:   "This resource manages git credentials in Octopus Deploy. Git credentials can be shared by version-controlled projects.",
+		Importer:      getImporter(),
+		ReadContext:   resourceGitCredentialRead,
+		Schema:        getGitCredentialSchema(),
+		UpdateContext: resourceGitCredentialUpdate,
+	}
+}
+
+func resourceGitCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	credential := expandGitCredential(d)
+
+	log.Printf("[INFO] creating git credential: %s", credential.Name)
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "GitCredentials")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdGitCredential := &gitCredential{}
+	if err := apiPost(client.Root.Sling, path, credential, createdGitCredential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGitCredential(ctx, d, createdGitCredential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] git credential created (%s)", d.Id())
+	return nil
+}
+
+func resourceGitCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting git credential (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "GitCredentials")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := apiDelete(client.Root.Sling, path+"/"+d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] git credential deleted")
+	return nil
+}
+
+func resourceGitCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading git credential (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	gitCredential, err := getGitCredential(client, d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] git credential (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setGitCredential(ctx, d, gitCredential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] git credential read (%s)", d.Id())
+	return nil
+}
+
+func resourceGitCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating git credential (%s)", d.Id())
+
+	credential := expandGitCredential(d)
+
+	// the password is only sent when it changes; otherwise, the server keeps
+	// the current one
+	if !d.HasChange("password") {
+		credential.Details.Password = &octopusdeploy.SensitiveValue{HasValue: true}
+	}
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "GitCredentials")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedGitCredential := &gitCredential{}
+	if err := apiUpdate(client.Root.Sling, path+"/"+d.Id(), credential, updatedGitCredential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGitCredential(ctx, d, updatedGitCredential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] git credential updated (%s)", d.Id())
+	return nil
+}
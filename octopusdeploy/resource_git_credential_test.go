@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitCredentialBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_git_credential." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	password := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	username := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	newPassword := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccGitCredentialCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccGitCredentialExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "password", password),
+					resource.TestCheckResourceAttr(prefix, "username", username),
+					resource.TestCheckResourceAttr("data.octopusdeploy_git_credentials."+localName, "git_credentials.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_git_credentials."+localName, "git_credentials.0.id", prefix, "id"),
+				),
+				Config: testAccGitCredentialBasic(localName, name, description, username, password),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccGitCredentialExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "password", newPassword),
+				),
+				Config: testAccGitCredentialBasic(localName, name, description, username, newPassword),
+			},
+			{
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ResourceName:            prefix,
+			},
+		},
+	})
+}
+
+func testAccGitCredentialBasic(localName string, name string, description string, username string, password string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_git_credential" "%s" {
+		description = "%s"
+		name        = "%s"
+		password    = "%s"
+		username    = "%s"
+	}
+
+	data "octopusdeploy_git_credentials" "%s" {
+		name = octopusdeploy_git_credential.%s.name
+	}`, localName, description, name, password, username, localName, localName)
+}
+
+func testAccGitCredentialExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		gitCredentialID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getGitCredential(client, gitCredentialID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccGitCredentialCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_git_credential" {
+			continue
+		}
+
+		gitCredential, err := getGitCredential(client, rs.Primary.ID)
+		if err == nil && gitCredential != nil {
+			return fmt.Errorf("git credential (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGoogleContainerRegistry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGoogleContainerRegistryCreate,
+		DeleteContext: resourceGoogleContainerRegistryDelete,
+		Description:   "This resource manages a Google Container Registry in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceGoogleContainerRegistryRead,
+		Schema:        getGoogleContainerRegistrySchema(),
+		UpdateContext: resourceGoogleContainerRegistryUpdate,
+	}
+}
+
+func resourceGoogleContainerRegistryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandGoogleContainerRegistry(d)
+
+	log.Printf("[INFO] creating Google Container Registry: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	createdFeed, err := addExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleContainerRegistry(ctx, d, createdFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Google Container Registry created (%s)", d.Id())
+	return nil
+}
+
+func resourceGoogleContainerRegistryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting Google Container Registry (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Feeds.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Google Container Registry deleted")
+	return nil
+}
+
+func resourceGoogleContainerRegistryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading Google Container Registry (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	feed, err := getExtendedFeedResource(client, d.Id(), feedTypeGoogleContainerRegistry)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] Google Container Registry (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleContainerRegistry(ctx, d, feed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Google Container Registry read (%s)", d.Id())
+	return nil
+}
+
+func resourceGoogleContainerRegistryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandGoogleContainerRegistry(d)
+
+	log.Printf("[INFO] updating Google Container Registry: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	updatedFeed, err := updateExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleContainerRegistry(ctx, d, updatedFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Google Container Registry updated (%s)", d.Id())
+	return nil
+}
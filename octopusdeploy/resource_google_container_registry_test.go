@@ -0,0 +1,78 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOctopusDeployGoogleContainerRegistry(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_google_container_registry." + localName
+
+	feedURI := "https://gcr.io"
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	registryPath := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testGoogleContainerRegistryCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testGoogleContainerRegistryExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "api_version", "v2"),
+					resource.TestCheckResourceAttr(prefix, "feed_uri", feedURI),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "registry_path", registryPath),
+					resource.TestCheckResourceAttr(prefix, "username", "_json_key"),
+				),
+				Config: testGoogleContainerRegistryBasic(localName, feedURI, name, registryPath),
+			},
+		},
+	})
+}
+
+func testGoogleContainerRegistryBasic(localName string, feedURI string, name string, registryPath string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_google_container_registry" "%s" {
+		api_version   = "v2"
+		feed_uri      = "%s"
+		name          = "%s"
+		password      = jsonencode({ type = "service_account" })
+		registry_path = "%s"
+		username      = "_json_key"
+	}`, localName, feedURI, name, registryPath)
+}
+
+func testGoogleContainerRegistryExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		feedID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getExtendedFeedResource(client, feedID, feedTypeGoogleContainerRegistry); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testGoogleContainerRegistryCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_google_container_registry" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		feed, err := client.Feeds.GetByID(rs.Primary.ID)
+		if err == nil && feed != nil {
+			return fmt.Errorf("Google Container Registry (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
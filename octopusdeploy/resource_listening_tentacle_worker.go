@@ -0,0 +1,98 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceListeningTentacleWorker() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceListeningTentacleWorkerCreate,
+		DeleteContext: resourceListeningTentacleWorkerDelete,
+		Description:   "This resource manages listening tentacle workers in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceListeningTentacleWorkerRead,
+		Schema:        getListeningTentacleWorkerSchema(),
+		UpdateContext: resourceListeningTentacleWorkerUpdate,
+	}
+}
+
+func resourceListeningTentacleWorkerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	worker := expandListeningTentacleWorker(d)
+
+	log.Printf("[INFO] creating listening tentacle worker: %#v", worker)
+
+	client := m.(*octopusdeploy.Client)
+	createdWorker, err := client.Workers.Add(worker)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setListeningTentacleWorker(ctx, d, createdWorker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdWorker.GetID())
+
+	log.Printf("[INFO] listening tentacle worker created (%s)", d.Id())
+	return nil
+}
+
+func resourceListeningTentacleWorkerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting listening tentacle worker (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Workers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] listening tentacle worker deleted")
+	return nil
+}
+
+func resourceListeningTentacleWorkerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading listening tentacle worker (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	worker, err := client.Workers.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] listening tentacle worker (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setListeningTentacleWorker(ctx, d, worker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] listening tentacle worker read (%s)", d.Id())
+	return nil
+}
+
+func resourceListeningTentacleWorkerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating listening tentacle worker (%s)", d.Id())
+
+	worker := expandListeningTentacleWorker(d)
+	client := m.(*octopusdeploy.Client)
+	updatedWorker, err := client.Workers.Update(worker)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setListeningTentacleWorker(ctx, d, updatedWorker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] listening tentacle worker updated (%s)", d.Id())
+	return nil
+}
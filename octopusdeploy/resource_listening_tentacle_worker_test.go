@@ -0,0 +1,131 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccListeningTentacleWorkerImportBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_listening_tentacle_worker." + localName
+
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccListeningTentacleWorkerCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListeningTentacleWorkerBasic(localName, name, false),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccListeningTentacleWorkerBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_listening_tentacle_worker." + localName
+
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccListeningTentacleWorkerCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccListeningTentacleWorkerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "machine_policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "tentacle_url", "https://example.com:1234/"),
+					resource.TestCheckResourceAttr(resourceName, "worker_pool_ids.#", "1"),
+				),
+				Config: testAccListeningTentacleWorkerBasic(localName, name, false),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccListeningTentacleWorkerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "true"),
+				),
+				Config: testAccListeningTentacleWorkerBasic(localName, name, true),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.octopusdeploy_workers."+localName, "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_workers."+localName, "workers.#", "1"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_workers."+localName, "workers.0.name", name),
+					resource.TestCheckResourceAttr("data.octopusdeploy_workers."+localName, "workers.0.endpoint.0.communication_style", "TentaclePassive"),
+				),
+				Config: testAccWorkerDataSource(localName, name),
+			},
+		},
+	})
+}
+
+func testAccListeningTentacleWorkerBasic(localName string, name string, isDisabled bool) string {
+	thumbprint := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(`data "octopusdeploy_machine_policies" "default" {
+		partial_name = "Default Machine Policy"
+	}`+"\n"+
+		testStaticWorkerPoolBasic(localName, name)+"\n"+`
+	resource "octopusdeploy_listening_tentacle_worker" "%s" {
+		is_disabled       = %v
+		machine_policy_id = "${data.octopusdeploy_machine_policies.default.machine_policies[0].id}"
+		name              = "%s"
+		tentacle_url      = "https://example.com:1234/"
+		thumbprint        = "%s"
+		worker_pool_ids   = ["${octopusdeploy_worker_pool.%s.id}"]
+	}`, localName, isDisabled, name, thumbprint, localName)
+}
+
+func testAccWorkerDataSource(localName string, name string) string {
+	return fmt.Sprintf(`%s
+
+	data "octopusdeploy_workers" "%s" {
+		communication_styles = ["TentaclePassive"]
+		name                 = octopusdeploy_listening_tentacle_worker.%s.name
+		worker_pool_ids      = [octopusdeploy_worker_pool.%s.id]
+	}`, testAccListeningTentacleWorkerBasic(localName, name, true), localName, localName, localName)
+}
+
+func testAccListeningTentacleWorkerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		workerID := s.RootModule().Resources[resourceName].Primary.ID
+		if _, err := client.Workers.GetByID(workerID); err != nil {
+			return fmt.Errorf("error retrieving worker: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccListeningTentacleWorkerCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_listening_tentacle_worker" {
+			continue
+		}
+
+		_, err := client.Workers.GetByID(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("worker (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMachineProxy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMachineProxyCreate,
+		DeleteContext: resourceMachineProxyDelete,
+		Description:   "This resource manages machine proxies in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceMachineProxyRead,
+		Schema:        getMachineProxySchema(),
+		UpdateContext: resourceMachineProxyUpdate,
+	}
+}
+
+func resourceMachineProxyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	proxy := expandMachineProxy(d)
+
+	log.Printf("[INFO] creating machine proxy: %s", proxy.Name)
+
+	client := m.(*octopusdeploy.Client)
+	createdMachineProxy := &machineProxy{}
+	if err := apiPost(client.Proxies.Sling, client.Proxies.BasePath, proxy, createdMachineProxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setMachineProxy(ctx, d, createdMachineProxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] machine proxy created (%s)", d.Id())
+	return nil
+}
+
+func resourceMachineProxyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting machine proxy (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := apiDelete(client.Proxies.Sling, client.Proxies.BasePath+"/"+d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] machine proxy deleted")
+	return nil
+}
+
+func resourceMachineProxyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading machine proxy (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	proxy, err := getMachineProxy(client, d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] machine proxy (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setMachineProxy(ctx, d, proxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] machine proxy read (%s)", d.Id())
+	return nil
+}
+
+func resourceMachineProxyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating machine proxy (%s)", d.Id())
+
+	proxy := expandMachineProxy(d)
+
+	// the password is only sent when it changes; otherwise, the server keeps
+	// the current one
+	if !d.HasChange("password") && len(d.Get("password").(string)) > 0 {
+		proxy.Password = &octopusdeploy.SensitiveValue{HasValue: true}
+	}
+
+	client := m.(*octopusdeploy.Client)
+	updatedMachineProxy := &machineProxy{}
+	if err := apiUpdate(client.Proxies.Sling, client.Proxies.BasePath+"/"+d.Id(), proxy, updatedMachineProxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setMachineProxy(ctx, d, updatedMachineProxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] machine proxy updated (%s)", d.Id())
+	return nil
+}
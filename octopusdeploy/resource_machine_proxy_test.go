@@ -0,0 +1,102 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMachineProxyBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_machine_proxy." + localName
+
+	host := "proxy.example.com"
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	password := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	port := 3128
+	username := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	newPassword := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newPort := 8080
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccMachineProxyCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccMachineProxyExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "host", host),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "password", password),
+					resource.TestCheckResourceAttr(prefix, "port", fmt.Sprint(port)),
+					resource.TestCheckResourceAttr(prefix, "username", username),
+					resource.TestCheckResourceAttr("data.octopusdeploy_machine_proxies."+localName, "machine_proxies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_machine_proxies."+localName, "machine_proxies.0.id", prefix, "id"),
+				),
+				Config: testAccMachineProxyBasic(localName, name, host, port, username, password),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccMachineProxyExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "password", newPassword),
+					resource.TestCheckResourceAttr(prefix, "port", fmt.Sprint(newPort)),
+				),
+				Config: testAccMachineProxyBasic(localName, name, host, newPort, username, newPassword),
+			},
+			{
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ResourceName:            prefix,
+			},
+		},
+	})
+}
+
+func testAccMachineProxyBasic(localName string, name string, host string, port int, username string, password string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_machine_proxy" "%s" {
+		host     = "%s"
+		name     = "%s"
+		password = "%s"
+		port     = %d
+		username = "%s"
+	}
+
+	data "octopusdeploy_machine_proxies" "%s" {
+		ids = [octopusdeploy_machine_proxy.%s.id]
+	}`, localName, host, name, password, port, username, localName, localName)
+}
+
+func testAccMachineProxyExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		machineProxyID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getMachineProxy(client, machineProxyID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccMachineProxyCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_machine_proxy" {
+			continue
+		}
+
+		proxy, err := getMachineProxy(client, rs.Primary.ID)
+		if err == nil && proxy != nil {
+			return fmt.Errorf("machine proxy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMaintenanceConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceConfigurationCreate,
+		DeleteContext: resourceMaintenanceConfigurationDelete,
+		Description:   "This resource manages the maintenance mode of the Octopus server. The setting is a singleton; it is adopted on creation and reset to its default (disabled) on deletion.",
+		Importer:      getImporter(),
+		ReadContext:   resourceMaintenanceConfigurationRead,
+		Schema:        getMaintenanceConfigurationSchema(),
+		UpdateContext: resourceMaintenanceConfigurationUpdate,
+	}
+}
+
+func resourceMaintenanceConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the maintenance configuration always exists; it is adopted and overwritten
+	log.Printf("[INFO] adopting maintenance configuration")
+
+	if err := resourceMaintenanceConfigurationUpdate(ctx, d, m); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] maintenance configuration adopted (%s)", d.Id())
+	return nil
+}
+
+func resourceMaintenanceConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] resetting maintenance configuration (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if _, err := updateMaintenanceConfiguration(client, &maintenanceConfiguration{ID: maintenanceConfigurationID}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] maintenance configuration reset")
+	return nil
+}
+
+func resourceMaintenanceConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading maintenance configuration (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	configuration, err := getMaintenanceConfiguration(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setMaintenanceConfiguration(ctx, d, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] maintenance configuration read (%s)", d.Id())
+	return nil
+}
+
+func resourceMaintenanceConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating maintenance configuration")
+
+	client := m.(*octopusdeploy.Client)
+	updatedConfiguration, err := updateMaintenanceConfiguration(client, expandMaintenanceConfiguration(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setMaintenanceConfiguration(ctx, d, updatedConfiguration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] maintenance configuration updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,49 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMaintenanceConfigurationBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_maintenance_configuration." + localName
+
+	// maintenance mode is not enabled since it would prevent the other tests
+	// from using the server
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccMaintenanceConfigurationCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "id", maintenanceConfigurationID),
+					resource.TestCheckResourceAttr(prefix, "is_in_maintenance_mode", "false"),
+				),
+				Config: fmt.Sprintf(`resource "octopusdeploy_maintenance_configuration" "%s" {
+					is_in_maintenance_mode = false
+				}`, localName),
+			},
+		},
+	})
+}
+
+func testAccMaintenanceConfigurationCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	configuration, err := getMaintenanceConfiguration(client)
+	if err != nil {
+		return err
+	}
+
+	if configuration.IsInMaintenanceMode {
+		return fmt.Errorf("maintenance mode was not reset")
+	}
+
+	return nil
+}
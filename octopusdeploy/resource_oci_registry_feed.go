@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOCIRegistryFeed() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOCIRegistryFeedCreate,
+		DeleteContext: resourceOCIRegistryFeedDelete,
+		Description:   "This resource manages an OCI registry feed in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceOCIRegistryFeedRead,
+		Schema:        getOCIRegistryFeedSchema(),
+		UpdateContext: resourceOCIRegistryFeedUpdate,
+	}
+}
+
+func resourceOCIRegistryFeedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandOCIRegistryFeed(d)
+
+	log.Printf("[INFO] creating OCI registry feed: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	createdFeed, err := addExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setOCIRegistryFeed(ctx, d, createdFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] OCI registry feed created (%s)", d.Id())
+	return nil
+}
+
+func resourceOCIRegistryFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting OCI registry feed (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Feeds.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] OCI registry feed deleted")
+	return nil
+}
+
+func resourceOCIRegistryFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading OCI registry feed (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	feed, err := getExtendedFeedResource(client, d.Id(), feedTypeOCIRegistry)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] OCI registry feed (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setOCIRegistryFeed(ctx, d, feed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] OCI registry feed read (%s)", d.Id())
+	return nil
+}
+
+func resourceOCIRegistryFeedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandOCIRegistryFeed(d)
+
+	log.Printf("[INFO] updating OCI registry feed: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	updatedFeed, err := updateExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setOCIRegistryFeed(ctx, d, updatedFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] OCI registry feed updated (%s)", d.Id())
+	return nil
+}
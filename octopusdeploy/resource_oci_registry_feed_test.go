@@ -0,0 +1,76 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOctopusDeployOCIRegistryFeed(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_oci_registry_feed." + localName
+
+	feedURI := "oci://registry-1.docker.io"
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	password := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	username := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testOCIRegistryFeedCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testOCIRegistryFeedExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "feed_uri", feedURI),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "password", password),
+					resource.TestCheckResourceAttr(prefix, "username", username),
+				),
+				Config: testOCIRegistryFeedBasic(localName, feedURI, name, username, password),
+			},
+		},
+	})
+}
+
+func testOCIRegistryFeedBasic(localName string, feedURI string, name string, username string, password string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_oci_registry_feed" "%s" {
+		feed_uri = "%s"
+		name     = "%s"
+		password = "%s"
+		username = "%s"
+	}`, localName, feedURI, name, password, username)
+}
+
+func testOCIRegistryFeedExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		feedID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getExtendedFeedResource(client, feedID, feedTypeOCIRegistry); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testOCIRegistryFeedCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_oci_registry_feed" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		feed, err := client.Feeds.GetByID(rs.Primary.ID)
+		if err == nil && feed != nil {
+			return fmt.Errorf("OCI registry feed (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package octopusdeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePackageCreate,
+		CustomizeDiff: resourcePackageCustomizeDiff,
+		DeleteContext: resourcePackageDelete,
+		Description:   "This resource manages a package of the built-in feed in Octopus Deploy.",
+		ReadContext:   resourcePackageRead,
+		Schema:        getBuiltInPackageSchema(),
+		UpdateContext: resourcePackageUpdate,
+	}
+}
+
+// resourcePackageCustomizeDiff compares the hash of the source file with the
+// hash of the package that was uploaded so that changes to its contents force
+// a new package. A source file that does not exist yet (e.g. one that is
+// produced by another resource in the same run) is hashed when it is uploaded.
+func resourcePackageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("source") {
+		return nil
+	}
+
+	source := d.Get("source").(string)
+	hash, err := getFileHash(source)
+	if os.IsNotExist(err) {
+		return d.SetNewComputed("content_hash")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to compute the hash of the package source (%s): %s", source, err)
+	}
+
+	if d.Get("content_hash").(string) != hash {
+		return d.SetNew("content_hash", hash)
+	}
+
+	return nil
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	source := d.Get("source").(string)
+	overwriteMode := d.Get("overwrite_mode").(string)
+
+	hash, err := getFileHash(source)
+	if err != nil {
+		return diag.Errorf("unable to compute the hash of the package source (%s): %s", source, err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	path, err := getLinkPath(client, "PackageUpload")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] uploading package: %s", source)
+
+	// an existing package is only adopted (with IgnoreIfExists) if the server
+	// rejects the upload of a new package; adopted packages are not deleted on
+	// destroy
+	isAdopted := false
+	uploadMode := overwriteMode
+	if overwriteMode == "IgnoreIfExists" {
+		uploadMode = "FailIfExists"
+	}
+
+	uploadedPackage, err := uploadBuiltInPackage(client, path, source, uploadMode)
+	if errors.Is(err, errBadRequest) && overwriteMode == "IgnoreIfExists" {
+		log.Printf("[INFO] package already exists (%s); adopting the existing package", err)
+		isAdopted = true
+		uploadedPackage, err = uploadBuiltInPackage(client, path, source, overwriteMode)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if isAdopted && len(uploadedPackage.Hash) > 0 && !strings.EqualFold(uploadedPackage.Hash, hash) {
+		return diag.Errorf("package (%s) already exists in the built-in feed with different contents; use OverwriteExisting to replace it", uploadedPackage.ID)
+	}
+
+	d.Set("adopted", isAdopted)
+	d.Set("content_hash", hash)
+
+	if err := setBuiltInPackage(ctx, d, uploadedPackage); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] package uploaded (%s)", d.Id())
+	return nil
+}
+
+func resourcePackageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("adopted").(bool) {
+		log.Printf("[INFO] package (%s) was adopted; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] deleting package (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Packages.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] package deleted")
+	return nil
+}
+
+func resourcePackageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading package (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	builtInPackage, err := getBuiltInPackage(client, d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] package (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setBuiltInPackage(ctx, d, builtInPackage); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] package read (%s)", d.Id())
+	return nil
+}
+
+// resourcePackageUpdate only applies changes to the overwrite mode, which is
+// used when the package is uploaded.
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourcePackageRead(ctx, d, m)
+}
+
+// uploadBuiltInPackage uploads a local file to the built-in feed.
+func uploadBuiltInPackage(client *octopusdeploy.Client, path string, source string, overwriteMode string) (*builtInPackage, error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	query := url.Values{}
+	query.Set("overwriteMode", overwriteMode)
+
+	uploadedPackage := &builtInPackage{}
+	if err := apiUpload(client.Packages.Sling, path+"?"+query.Encode(), filepath.Base(source), file, uploadedPackage); err != nil {
+		return nil, err
+	}
+
+	return uploadedPackage, nil
+}
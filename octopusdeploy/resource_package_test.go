@@ -0,0 +1,163 @@
+package octopusdeploy
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPackageBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_package." + localName
+
+	packageID := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	source := filepath.Join(t.TempDir(), packageID+".1.0.0.zip")
+	if err := testAccPackageWriteSource(source, "version 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	var contentHash string
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccPackageCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccPackageExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "adopted", "false"),
+					resource.TestCheckResourceAttrSet(prefix, "content_hash"),
+					testAccPackageContentHash(prefix, &contentHash),
+					resource.TestCheckResourceAttr(prefix, "feed_id", "feeds-builtin"),
+					resource.TestCheckResourceAttr(prefix, "file_extension", ".zip"),
+					resource.TestCheckResourceAttr(prefix, "package_id", packageID),
+					resource.TestCheckResourceAttr(prefix, "version", "1.0.0"),
+				),
+				Config: testAccPackageBasic(localName, source),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccPackageExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "package_id", packageID),
+					testAccPackageContentHashChanged(prefix, &contentHash),
+				),
+				Config:    testAccPackageBasic(localName, source),
+				PreConfig: func() { testAccPackageWriteSource(source, "version 2") },
+			},
+		},
+	})
+}
+
+func TestAccPackageSourceCreatedDuringApply(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_package." + localName
+
+	packageID := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	source := filepath.Join(t.TempDir(), packageID+".1.0.0.zip")
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccPackageCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccPackageBasic(localName, source),
+				ExpectNonEmptyPlan: true,
+				PlanOnly:           true,
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccPackageExists(prefix),
+					resource.TestCheckResourceAttrSet(prefix, "content_hash"),
+					resource.TestCheckResourceAttr(prefix, "package_id", packageID),
+				),
+				Config: testAccPackageBasic(localName, source),
+				PreConfig: func() {
+					if err := testAccPackageWriteSource(source, "version 1"); err != nil {
+						t.Fatal(err)
+					}
+				},
+			},
+		},
+	})
+}
+
+func testAccPackageContentHash(prefix string, contentHash *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*contentHash = s.RootModule().Resources[prefix].Primary.Attributes["content_hash"]
+		return nil
+	}
+}
+
+func testAccPackageContentHashChanged(prefix string, contentHash *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		newContentHash := s.RootModule().Resources[prefix].Primary.Attributes["content_hash"]
+		if len(newContentHash) == 0 || newContentHash == *contentHash {
+			return fmt.Errorf("content hash of package (%s) did not change: %s", prefix, newContentHash)
+		}
+		return nil
+	}
+}
+
+func testAccPackageBasic(localName string, source string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_package" "%s" {
+		overwrite_mode = "OverwriteExisting"
+		source         = "%s"
+	}`, localName, filepath.ToSlash(source))
+}
+
+func testAccPackageWriteSource(source string, contents string) error {
+	file, err := os.Create(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	entry, err := writer.Create("README.txt")
+	if err != nil {
+		return err
+	}
+
+	if _, err := entry.Write([]byte(contents)); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+func testAccPackageExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		packageID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getBuiltInPackage(client, packageID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_package" {
+			continue
+		}
+
+		builtInPackage, err := getBuiltInPackage(client, rs.Primary.ID)
+		if err == nil && builtInPackage != nil {
+			return fmt.Errorf("package (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePollingTentacleWorker() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePollingTentacleWorkerCreate,
+		DeleteContext: resourcePollingTentacleWorkerDelete,
+		Description:   "This resource manages polling tentacle workers in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourcePollingTentacleWorkerRead,
+		Schema:        getPollingTentacleWorkerSchema(),
+		UpdateContext: resourcePollingTentacleWorkerUpdate,
+	}
+}
+
+func resourcePollingTentacleWorkerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	worker := expandPollingTentacleWorker(d)
+
+	log.Printf("[INFO] creating polling tentacle worker: %#v", worker)
+
+	client := m.(*octopusdeploy.Client)
+	createdWorker, err := client.Workers.Add(worker)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setPollingTentacleWorker(ctx, d, createdWorker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdWorker.GetID())
+
+	log.Printf("[INFO] polling tentacle worker created (%s)", d.Id())
+	return nil
+}
+
+func resourcePollingTentacleWorkerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting polling tentacle worker (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Workers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] polling tentacle worker deleted")
+	return nil
+}
+
+func resourcePollingTentacleWorkerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading polling tentacle worker (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	worker, err := client.Workers.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] polling tentacle worker (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setPollingTentacleWorker(ctx, d, worker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] polling tentacle worker read (%s)", d.Id())
+	return nil
+}
+
+func resourcePollingTentacleWorkerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating polling tentacle worker (%s)", d.Id())
+
+	worker := expandPollingTentacleWorker(d)
+	client := m.(*octopusdeploy.Client)
+	updatedWorker, err := client.Workers.Update(worker)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setPollingTentacleWorker(ctx, d, updatedWorker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] polling tentacle worker updated (%s)", d.Id())
+	return nil
+}
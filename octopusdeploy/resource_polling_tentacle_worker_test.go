@@ -0,0 +1,95 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPollingTentacleWorkerBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_polling_tentacle_worker." + localName
+
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+	tentacleURL := "poll://" + acctest.RandStringFromCharSet(20, "abcdefghijklmnopqrstuvwxyz") + "/"
+	thumbprint := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccPollingTentacleWorkerCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccPollingTentacleWorkerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "machine_policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "tentacle_url", tentacleURL),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint", thumbprint),
+					resource.TestCheckResourceAttr(resourceName, "worker_pool_ids.#", "1"),
+				),
+				Config: testAccPollingTentacleWorkerBasic(localName, name, tentacleURL, thumbprint, false),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccPollingTentacleWorkerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "true"),
+				),
+				Config: testAccPollingTentacleWorkerBasic(localName, name, tentacleURL, thumbprint, true),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPollingTentacleWorkerBasic(localName string, name string, tentacleURL string, thumbprint string, isDisabled bool) string {
+	return fmt.Sprintf(`data "octopusdeploy_machine_policies" "default" {
+		partial_name = "Default Machine Policy"
+	}`+"\n"+
+		testStaticWorkerPoolBasic(localName, name)+"\n"+`
+	resource "octopusdeploy_polling_tentacle_worker" "%s" {
+		is_disabled       = %v
+		machine_policy_id = "${data.octopusdeploy_machine_policies.default.machine_policies[0].id}"
+		name              = "%s"
+		tentacle_url      = "%s"
+		thumbprint        = "%s"
+		worker_pool_ids   = ["${octopusdeploy_worker_pool.%s.id}"]
+	}`, localName, isDisabled, name, tentacleURL, thumbprint, localName)
+}
+
+func testAccPollingTentacleWorkerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		workerID := s.RootModule().Resources[resourceName].Primary.ID
+		if _, err := client.Workers.GetByID(workerID); err != nil {
+			return fmt.Errorf("error retrieving worker: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccPollingTentacleWorkerCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_polling_tentacle_worker" {
+			continue
+		}
+
+		_, err := client.Workers.GetByID(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("worker (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceProjectCreate,
+		CustomizeDiff: resourceProjectCustomizeDiff,
 		DeleteContext: resourceProjectDelete,
 		Description:   "This resource manages projects in Octopus Deploy.",
 		Importer:      getImporter(),
@@ -21,18 +22,31 @@ func resourceProject() *schema.Resource {
 	}
 }
 
+func resourceProjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, v := range d.Get("version_control_settings").(*schema.Set).List() {
+		if err := validateVersionControlSettings(v.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	project := expandProject(d)
+	project, err := expandVersionControlledProject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("[INFO] creating project: %#v", project)
 
 	client := m.(*octopusdeploy.Client)
-	createdProject, err := client.Projects.Add(project)
-	if err != nil {
+	createdProject := &versionControlledProject{Project: &octopusdeploy.Project{}}
+	if err := apiAdd(client.Projects.Sling, client.Projects.BasePath, project, createdProject); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := setProject(ctx, d, createdProject); err != nil {
+	if err := setVersionControlledProject(ctx, d, createdProject); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -60,10 +74,10 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	log.Printf("[INFO] reading project (%s)", d.Id())
 
 	client := m.(*octopusdeploy.Client)
-	project, err := client.Projects.GetByID(d.Id())
-	if err != nil {
-		apiError := err.(*octopusdeploy.APIError)
-		if apiError.StatusCode == 404 {
+	project := &versionControlledProject{Project: &octopusdeploy.Project{}}
+	if err := apiGet(client.Projects.Sling, client.Projects.BasePath+"/"+d.Id(), project); err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
 			log.Printf("[INFO] project (%s) not found; deleting from state", d.Id())
 			d.SetId("")
 			return nil
@@ -71,7 +85,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	if err := setProject(ctx, d, project); err != nil {
+	if err := setVersionControlledProject(ctx, d, project); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -82,14 +96,18 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] updating project (%s)", d.Id())
 
-	project := expandProject(d)
-	client := m.(*octopusdeploy.Client)
-	updatedProject, err := client.Projects.Update(project)
+	project, err := expandVersionControlledProject(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := setProject(ctx, d, updatedProject); err != nil {
+	client := m.(*octopusdeploy.Client)
+	updatedProject := &versionControlledProject{Project: &octopusdeploy.Project{}}
+	if err := apiUpdate(client.Projects.Sling, client.Projects.BasePath+"/"+d.Id(), project, updatedProject); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setVersionControlledProject(ctx, d, updatedProject); err != nil {
 		return diag.FromErr(err)
 	}
 
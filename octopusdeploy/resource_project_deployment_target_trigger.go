@@ -2,7 +2,6 @@ package octopusdeploy
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
@@ -10,6 +9,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// the event groups and categories of the events that are raised for
+// deployment targets
+var machineEventGroups = []string{
+	"Machine",
+	"MachineCritical",
+	"MachineAvailableForDeployment",
+	"MachineUnavailableForDeployment",
+	"MachineHealthChanged",
+}
+
+var machineEventCategories = []string{
+	"MachineCleanupFailed",
+	"MachineAdded",
+	"MachineDeploymentRelatedPropertyWasUpdated",
+	"MachineDisabled",
+	"MachineEnabled",
+	"MachineHealthy",
+	"MachineUnavailable",
+	"MachineUnhealthy",
+	"MachineHasWarnings",
+}
+
 func resourceProjectDeploymentTargetTrigger() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceProjectDeploymentTargetTriggerCreate,
@@ -78,16 +99,8 @@ func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData) (*octop
 		eventGroups := getSliceFromTerraformTypeList(attr)
 
 		// need to validate here "ValidateFunc is not yet supported on lists or sets."
-		validValues := []string{
-			"Machine",
-			"MachineCritical",
-			"MachineAvailableForDeployment",
-			"MachineUnavailableForDeployment",
-			"MachineHealthChanged",
-		}
-
-		if invalidValue, ok := validateAllSliceItemsInSlice(eventGroups, validValues); !ok {
-			return nil, fmt.Errorf("Invalid value for event_groups. %s not in %v", invalidValue, validValues)
+		if err := validateEventFilter("event_groups", eventGroups, machineEventGroups); err != nil {
+			return nil, err
 		}
 
 		deploymentTargetTrigger.AddEventGroups(eventGroups)
@@ -97,20 +110,8 @@ func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData) (*octop
 		eventCategories := getSliceFromTerraformTypeList(attr)
 
 		// need to validate here "ValidateFunc is not yet supported on lists or sets."
-		validValues := []string{
-			"MachineCleanupFailed",
-			"MachineAdded",
-			"MachineDeploymentRelatedPropertyWasUpdated",
-			"MachineDisabled",
-			"MachineEnabled",
-			"MachineHealthy",
-			"MachineUnavailable",
-			"MachineUnhealthy",
-			"MachineHasWarnings",
-		}
-
-		if invalidValue, ok := validateAllSliceItemsInSlice(eventCategories, validValues); !ok {
-			return nil, fmt.Errorf("Invalid value for event_categories. %s not in %v", invalidValue, validValues)
+		if err := validateEventFilter("event_categories", eventCategories, machineEventCategories); err != nil {
+			return nil, err
 		}
 
 		deploymentTargetTrigger.AddEventCategories(eventCategories)
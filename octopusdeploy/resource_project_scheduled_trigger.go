@@ -0,0 +1,129 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectScheduledTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectScheduledTriggerCreate,
+		CustomizeDiff: resourceProjectScheduledTriggerCustomizeDiff,
+		DeleteContext: resourceProjectScheduledTriggerDelete,
+		Description:   "This resource manages scheduled project triggers in Octopus Deploy. Schedules that run only once at a specific date and time are not supported.",
+		Importer:      getImporter(),
+		ReadContext:   resourceProjectScheduledTriggerRead,
+		Schema:        getProjectScheduledTriggerSchema(),
+		UpdateContext: resourceProjectScheduledTriggerUpdate,
+	}
+}
+
+func resourceProjectScheduledTriggerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, key := range []string{"date_of_month", "day_number_of_month", "day_of_week", "monthly_schedule_type"} {
+		if !d.NewValueKnown("days_per_month_schedule.0." + key) {
+			return nil
+		}
+	}
+
+	for _, v := range d.Get("days_per_month_schedule").([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		if err := validateDaysPerMonthSchedule(v.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceProjectScheduledTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectScheduledTrigger := expandProjectScheduledTrigger(d)
+
+	log.Printf("[INFO] creating scheduled project trigger: %#v", projectScheduledTrigger)
+
+	// scheduled triggers are project triggers; the project trigger service is
+	// used for its paths since its model does not support schedules
+	client := m.(*octopusdeploy.Client)
+	createdProjectScheduledTrigger := &scheduledProjectTrigger{}
+	if err := apiAdd(client.ProjectTriggers.Sling, client.ProjectTriggers.BasePath, projectScheduledTrigger, createdProjectScheduledTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectScheduledTrigger(ctx, d, createdProjectScheduledTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] scheduled project trigger created (%s)", d.Id())
+	return nil
+}
+
+func resourceProjectScheduledTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting scheduled project trigger (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.ProjectTriggers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] scheduled project trigger deleted")
+	return nil
+}
+
+func resourceProjectScheduledTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading scheduled project trigger (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	path, err := client.ProjectTriggers.URITemplate.Expand(map[string]interface{}{"id": d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectScheduledTrigger := &scheduledProjectTrigger{}
+	if err := apiGet(client.ProjectTriggers.Sling, path, projectScheduledTrigger); err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] scheduled project trigger (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectScheduledTrigger(ctx, d, projectScheduledTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] scheduled project trigger read (%s)", d.Id())
+	return nil
+}
+
+func resourceProjectScheduledTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating scheduled project trigger (%s)", d.Id())
+
+	projectScheduledTrigger := expandProjectScheduledTrigger(d)
+	client := m.(*octopusdeploy.Client)
+	path, err := client.ProjectTriggers.URITemplate.Expand(map[string]interface{}{"id": d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedProjectScheduledTrigger := &scheduledProjectTrigger{}
+	if err := apiUpdate(client.ProjectTriggers.Sling, path, projectScheduledTrigger, updatedProjectScheduledTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectScheduledTrigger(ctx, d, updatedProjectScheduledTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] scheduled project trigger updated (%s)", d.Id())
+	return nil
+}
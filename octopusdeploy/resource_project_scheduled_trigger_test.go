@@ -0,0 +1,208 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectScheduledTriggerCronExpression(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_project_scheduled_trigger." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccProjectScheduledTriggerCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectScheduledTriggerExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "cron_expression_schedule.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "cron_expression_schedule.0.cron_expression", "0 0 06 * * Mon-Fri"),
+					resource.TestCheckResourceAttr(prefix, "cron_expression_schedule.0.timezone", "Australia/Brisbane"),
+					resource.TestCheckResourceAttr(prefix, "deploy_new_release_action.#", "1"),
+				),
+				Config: testAccProjectScheduledTriggerCronExpression(localName, name, "0 0 06 * * Mon-Fri", "Australia/Brisbane"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectScheduledTriggerExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "cron_expression_schedule.0.cron_expression", "0 30 18 * * *"),
+					resource.TestCheckResourceAttr(prefix, "cron_expression_schedule.0.timezone", "UTC"),
+				),
+				Config: testAccProjectScheduledTriggerCronExpression(localName, name, "0 30 18 * * *", "UTC"),
+			},
+			{
+				ResourceName:      prefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectScheduledTriggerSchemaValidation(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectScheduledTriggerCronExpression(localName, name, "0 0 25 * * *", "UTC"),
+				ExpectError: regexp.MustCompile("invalid cron expression"),
+			},
+			{
+				Config:      testAccProjectScheduledTriggerCronExpression(localName, name, "0 0 06 * * *", "Mars/Olympus_Mons"),
+				ExpectError: regexp.MustCompile("invalid time zone"),
+			},
+			{
+				Config:      testAccProjectScheduledTriggerDaysPerMonth(localName, name, "DateOfMonth"),
+				ExpectError: regexp.MustCompile("date_of_month must be specified"),
+			},
+			{
+				Config:      testAccProjectScheduledTriggerDaysPerMonth(localName, name, "DayOfMonth"),
+				ExpectError: regexp.MustCompile("day_number_of_month and day_of_week must be specified"),
+			},
+		},
+	})
+}
+
+func TestAccProjectScheduledTriggerOnceDailyRunbook(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_project_scheduled_trigger." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccProjectScheduledTriggerCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectScheduledTriggerExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "once_daily_schedule.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "once_daily_schedule.0.days_of_week.#", "2"),
+					resource.TestCheckResourceAttr(prefix, "run_runbook_action.#", "1"),
+					resource.TestCheckResourceAttrPair(prefix, "run_runbook_action.0.runbook_id", "octopusdeploy_runbook."+localName, "id"),
+				),
+				Config: testAccProjectScheduledTriggerOnceDailyRunbook(localName, name),
+			},
+		},
+	})
+}
+
+func testAccProjectScheduledTriggerProject(localName string) string {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, localName, projectName, projectDescription)+"\n"+
+		`resource "octopusdeploy_environment" "%s" {
+			name = "%s"
+		}`, localName, environmentName)
+}
+
+func testAccProjectScheduledTriggerCronExpression(localName string, name string, cronExpression string, timeZone string) string {
+	return fmt.Sprintf(testAccProjectScheduledTriggerProject(localName)+"\n"+
+		`resource "octopusdeploy_project_scheduled_trigger" "%s" {
+			name       = "%s"
+			project_id = octopusdeploy_project.%s.id
+
+			cron_expression_schedule {
+				cron_expression = "%s"
+				timezone        = "%s"
+			}
+
+			deploy_new_release_action {
+				destination_environment_id = octopusdeploy_environment.%s.id
+			}
+		}`, localName, name, localName, cronExpression, timeZone, localName)
+}
+
+func testAccProjectScheduledTriggerDaysPerMonth(localName string, name string, monthlyScheduleType string) string {
+	return fmt.Sprintf(testAccProjectScheduledTriggerProject(localName)+"\n"+
+		`resource "octopusdeploy_project_scheduled_trigger" "%s" {
+			name       = "%s"
+			project_id = octopusdeploy_project.%s.id
+
+			days_per_month_schedule {
+				monthly_schedule_type = "%s"
+				start_time            = "2021-01-01T09:00:00Z"
+				timezone              = "UTC"
+			}
+
+			deploy_new_release_action {
+				destination_environment_id = octopusdeploy_environment.%s.id
+			}
+		}`, localName, name, localName, monthlyScheduleType, localName)
+}
+
+func testAccProjectScheduledTriggerOnceDailyRunbook(localName string, name string) string {
+	runbookName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccProjectScheduledTriggerProject(localName)+"\n"+
+		`resource "octopusdeploy_runbook" "%s" {
+			name       = "%s"
+			project_id = octopusdeploy_project.%s.id
+		}
+
+		resource "octopusdeploy_project_scheduled_trigger" "%s" {
+			name       = "%s"
+			project_id = octopusdeploy_project.%s.id
+
+			once_daily_schedule {
+				days_of_week = ["Monday", "Friday"]
+				start_time   = "2021-01-01T09:00:00Z"
+				timezone     = "E. Australia Standard Time"
+			}
+
+			run_runbook_action {
+				runbook_id             = octopusdeploy_runbook.%s.id
+				target_environment_ids = [octopusdeploy_environment.%s.id]
+			}
+		}`, localName, runbookName, localName, localName, name, localName, localName, localName)
+}
+
+func testAccProjectScheduledTriggerExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		projectTriggerID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.ProjectTriggers.GetByID(projectTriggerID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectScheduledTriggerCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_project_scheduled_trigger" {
+			continue
+		}
+
+		projectTrigger, err := client.ProjectTriggers.GetByID(rs.Primary.ID)
+		if err == nil && projectTrigger != nil {
+			return fmt.Errorf("scheduled project trigger (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
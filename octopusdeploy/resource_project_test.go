@@ -2,6 +2,8 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
@@ -34,14 +36,142 @@ func TestAccOctopusDeployProjectBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckOctopusDeployProjectExists(prefix),
 					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "is_version_controlled", "false"),
 					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "version_control_settings.#", "0"),
 				),
 				Config: testAccProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, localName, name, description),
 			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+// TestAccProjectWithGitCredential requires a git repository that is
+// accessible to the Octopus server (OCTOPUS_GIT_URL, OCTOPUS_GIT_USERNAME and
+// OCTOPUS_GIT_PASSWORD).
+func TestAccProjectWithGitCredential(t *testing.T) {
+	url := os.Getenv("OCTOPUS_GIT_URL")
+	username := os.Getenv("OCTOPUS_GIT_USERNAME")
+	password := os.Getenv("OCTOPUS_GIT_PASSWORD")
+	if isEmpty(url) || isEmpty(username) || isEmpty(password) {
+		t.Skip("OCTOPUS_GIT_URL, OCTOPUS_GIT_USERNAME and OCTOPUS_GIT_PASSWORD must be set for version-controlled projects")
+	}
+
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	gitCredentialName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_project." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testAccGitCredentialCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployProjectExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "is_version_controlled", "true"),
+					resource.TestCheckResourceAttr(prefix, "version_control_settings.#", "1"),
+					testAccProjectGitCredentialID(prefix, "octopusdeploy_git_credential."+localName),
+				),
+				Config: testAccProjectWithGitCredential(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, localName, name, gitCredentialName, url, username, password),
+			},
 		},
 	})
 }
 
+func TestAccProjectGitCredentialConflict(t *testing.T) {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccLifecycleBasic(lifecycleLocalName, lifecycleName)+"\n"+
+					testAccProjectGroupBasic(projectGroupLocalName, projectGroupName)+"\n"+
+					`resource "octopusdeploy_project" "%s" {
+						is_version_controlled = true
+						lifecycle_id          = octopusdeploy_lifecycle.%s.id
+						name                  = "%s"
+						project_group_id      = octopusdeploy_project_group.%s.id
+
+						version_control_settings {
+							default_branch    = "main"
+							git_credential_id = "GitCredentials-1"
+							url               = "https://example.com/repository.git"
+							username          = "username"
+						}
+					}`, localName, lifecycleLocalName, name, projectGroupLocalName),
+				ExpectError: regexp.MustCompile("git_credential_id cannot be used together with username or password"),
+			},
+		},
+	})
+}
+
+func testAccProjectWithGitCredential(lifecycleLocalName string, lifecycleName string, projectGroupLocalName string, projectGroupName string, localName string, name string, gitCredentialName string, url string, username string, password string) string {
+	return fmt.Sprintf(testAccLifecycleBasic(lifecycleLocalName, lifecycleName)+"\n"+
+		testAccProjectGroupBasic(projectGroupLocalName, projectGroupName)+"\n"+
+		`resource "octopusdeploy_git_credential" "%s" {
+			name     = "%s"
+			password = "%s"
+			username = "%s"
+		}
+
+		resource "octopusdeploy_project" "%s" {
+			is_version_controlled = true
+			lifecycle_id          = octopusdeploy_lifecycle.%s.id
+			name                  = "%s"
+			project_group_id      = octopusdeploy_project_group.%s.id
+
+			version_control_settings {
+				base_path         = ".octopus/%s"
+				default_branch    = "main"
+				git_credential_id = octopusdeploy_git_credential.%s.id
+				url               = "%s"
+			}
+		}`, localName, gitCredentialName, password, username, localName, lifecycleLocalName, name, projectGroupLocalName, localName, localName, url)
+}
+
+// testAccProjectGitCredentialID checks that the version control settings of
+// a project reference the git credential.
+func testAccProjectGitCredentialID(prefix string, gitCredentialPrefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		projectID := s.RootModule().Resources[prefix].Primary.ID
+		gitCredentialID := s.RootModule().Resources[gitCredentialPrefix].Primary.ID
+
+		project := &versionControlledProject{Project: &octopusdeploy.Project{}}
+		if err := apiGet(client.Projects.Sling, client.Projects.BasePath+"/"+projectID, project); err != nil {
+			return err
+		}
+
+		if project.VersionControlSettings == nil || project.VersionControlSettings.Credentials == nil || project.VersionControlSettings.Credentials.ID != gitCredentialID {
+			return fmt.Errorf("project (%s) does not reference git credential (%s)", projectID, gitCredentialID)
+		}
+
+		return nil
+	}
+}
+
 func TestAccOctopusDeployProjectWithUpdate(t *testing.T) {
 	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
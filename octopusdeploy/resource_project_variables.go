@@ -0,0 +1,146 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectVariables() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectVariablesCreate,
+		DeleteContext: resourceProjectVariablesDelete,
+		Description:   "This resource manages the entire variable set of a project or library variable set in Octopus Deploy. Variables that are not declared are removed from the variable set.",
+		Importer:      getImporter(),
+		ReadContext:   resourceProjectVariablesRead,
+		Schema:        getProjectVariablesSchema(),
+		UpdateContext: resourceProjectVariablesUpdate,
+	}
+}
+
+func resourceProjectVariablesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ownerID := d.Get("owner_id").(string)
+
+	log.Printf("[INFO] creating variables of owner (%s)", ownerID)
+
+	client := m.(*octopusdeploy.Client)
+	if err := updateProjectVariables(ctx, d, client, ownerID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] variables of owner (%s) created", d.Id())
+	return nil
+}
+
+func resourceProjectVariablesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting variables of owner (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	variableSet, err := client.Variables.GetAll(d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	variableSet.Variables = []*octopusdeploy.Variable{}
+	if _, err := client.Variables.Update(d.Id(), variableSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] variables deleted")
+	return nil
+}
+
+func resourceProjectVariablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading variables of owner (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	variableSet, err := client.Variables.GetAll(d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] variables of owner (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectVariables(ctx, d, &variableSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] variables of owner (%s) read", d.Id())
+	return nil
+}
+
+func resourceProjectVariablesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating variables of owner (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := updateProjectVariables(ctx, d, client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] variables of owner (%s) updated", d.Id())
+	return nil
+}
+
+// updateProjectVariables replaces the variables of a variable set with the
+// declared variables in a single request.
+func updateProjectVariables(ctx context.Context, d *schema.ResourceData, client *octopusdeploy.Client, ownerID string) error {
+	variableSet, err := client.Variables.GetAll(ownerID)
+	if err != nil {
+		return err
+	}
+
+	variableSet.Variables, err = expandProjectVariables(d.Get("variable").([]interface{}), variableSet.Variables)
+	if err != nil {
+		return err
+	}
+
+	updatedVariableSet, err := client.Variables.Update(ownerID, variableSet)
+	if err != nil {
+		return err
+	}
+
+	// the IDs of new variables are assigned by the server; they are matched by
+	// name and scope in the order in which the variables are declared
+	usedIDs := map[string]bool{}
+	for _, variable := range variableSet.Variables {
+		usedIDs[variable.GetID()] = true
+	}
+
+	declaredVariables := []interface{}{}
+	for i, v := range d.Get("variable").([]interface{}) {
+		declaredVariable := v.(map[string]interface{})
+		id := variableSet.Variables[i].GetID()
+		if len(id) == 0 {
+			key := getProjectVariableKey(variableSet.Variables[i])
+			for _, updatedVariable := range updatedVariableSet.Variables {
+				if !usedIDs[updatedVariable.GetID()] && getProjectVariableKey(updatedVariable) == key {
+					id = updatedVariable.GetID()
+					usedIDs[id] = true
+					break
+				}
+			}
+		}
+		declaredVariable["id"] = id
+		declaredVariables = append(declaredVariables, declaredVariable)
+	}
+
+	if err := d.Set("variable", declaredVariables); err != nil {
+		return err
+	}
+
+	return setProjectVariables(ctx, d, &updatedVariableSet)
+}
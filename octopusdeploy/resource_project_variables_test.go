@@ -0,0 +1,146 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccProjectVariablesBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_project_variables." + localName
+
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	sensitiveValue := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	value := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newValue := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccProjectVariablesCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectVariablesExists(prefix, 3),
+					resource.TestCheckResourceAttrPair(prefix, "owner_id", "octopusdeploy_project."+localName, "id"),
+					resource.TestCheckResourceAttr(prefix, "variable.#", "3"),
+					resource.TestCheckResourceAttr(prefix, "variable.0.name", "Greeting"),
+					resource.TestCheckResourceAttr(prefix, "variable.0.value", value),
+					resource.TestCheckResourceAttrSet(prefix, "variable.0.id"),
+					resource.TestCheckResourceAttr(prefix, "variable.1.name", "Greeting"),
+					resource.TestCheckResourceAttr(prefix, "variable.1.scope.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "variable.1.scope.0.environments.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "variable.2.sensitive_value", sensitiveValue),
+					resource.TestCheckResourceAttr(prefix, "variable.2.type", "Sensitive"),
+				),
+				Config: testAccProjectVariablesBasic(localName, environmentName, lifecycleName, projectGroupName, projectName, value, sensitiveValue, true),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectVariablesExists(prefix, 2),
+					resource.TestCheckResourceAttr(prefix, "variable.#", "2"),
+					resource.TestCheckResourceAttr(prefix, "variable.0.value", newValue),
+					resource.TestCheckResourceAttr(prefix, "variable.1.sensitive_value", sensitiveValue),
+				),
+				Config: testAccProjectVariablesBasic(localName, environmentName, lifecycleName, projectGroupName, projectName, newValue, sensitiveValue, false),
+			},
+		},
+	})
+}
+
+func TestFlattenProjectVariables(t *testing.T) {
+	first := octopusdeploy.NewVariable("First")
+	first.ID = "first"
+	second := octopusdeploy.NewVariable("Second")
+	second.ID = "second"
+	second.IsSensitive = true
+	unknown := octopusdeploy.NewVariable("Unknown")
+	unknown.ID = "unknown"
+
+	state := []interface{}{
+		map[string]interface{}{"id": "second", "scope": nil, "sensitive_value": "secret"},
+		map[string]interface{}{"id": "first", "scope": nil, "sensitive_value": ""},
+	}
+
+	flattenedVariables := flattenProjectVariables([]*octopusdeploy.Variable{unknown, first, second}, state)
+	require.Len(t, flattenedVariables, 3)
+	require.Equal(t, "second", flattenedVariables[0].(map[string]interface{})["id"])
+	require.Equal(t, "secret", flattenedVariables[0].(map[string]interface{})["sensitive_value"])
+	require.Equal(t, "first", flattenedVariables[1].(map[string]interface{})["id"])
+	require.Equal(t, "unknown", flattenedVariables[2].(map[string]interface{})["id"])
+}
+
+func testAccProjectVariablesBasic(localName string, environmentName string, lifecycleName string, projectGroupName string, projectName string, value string, sensitiveValue string, isScoped bool) string {
+	scopedVariable := ""
+	if isScoped {
+		scopedVariable = fmt.Sprintf(`variable {
+				name  = "Greeting"
+				value = "scoped-%s"
+
+				scope {
+					environments = [octopusdeploy_environment.%s.id]
+				}
+			}`, value, localName)
+	}
+
+	return fmt.Sprintf(testAccProjectBasic(localName, lifecycleName, localName, projectGroupName, localName, projectName, "")+"\n"+
+		testEnvironmentMinimum(localName, environmentName)+"\n"+
+		`resource "octopusdeploy_project_variables" "%s" {
+			owner_id = octopusdeploy_project.%s.id
+
+			variable {
+				name  = "Greeting"
+				value = "%s"
+			}
+
+			%s
+
+			variable {
+				name            = "Password"
+				sensitive_value = "%s"
+				type            = "Sensitive"
+			}
+		}`, localName, localName, value, scopedVariable, sensitiveValue)
+}
+
+func testAccProjectVariablesExists(prefix string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		ownerID := s.RootModule().Resources[prefix].Primary.ID
+		variableSet, err := client.Variables.GetAll(ownerID)
+		if err != nil {
+			return err
+		}
+
+		if len(variableSet.Variables) != count {
+			return fmt.Errorf("expected %d variables but found %d", count, len(variableSet.Variables))
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectVariablesCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_project_variables" {
+			continue
+		}
+
+		variableSet, err := client.Variables.GetAll(rs.Primary.ID)
+		if err == nil && len(variableSet.Variables) > 0 {
+			return fmt.Errorf("variables of owner (%s) still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
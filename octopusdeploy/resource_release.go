@@ -0,0 +1,170 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRelease() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReleaseCreate,
+		DeleteContext: resourceReleaseDelete,
+		Description:   "This resource manages releases in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceReleaseRead,
+		Schema:        getReleaseSchema(),
+		UpdateContext: resourceReleaseUpdate,
+	}
+}
+
+func resourceReleaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	release := expandRelease(d)
+
+	client := m.(*octopusdeploy.Client)
+	project, err := client.Projects.GetByID(release.ProjectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if project.IsVersionControlled && release.VersionControlReference == nil {
+		return diag.Errorf("project (%s) is version-controlled; git_ref must be specified", project.GetID())
+	}
+
+	if !project.IsVersionControlled && release.VersionControlReference != nil {
+		return diag.Errorf("project (%s) is not version-controlled; git_ref cannot be specified", project.GetID())
+	}
+
+	channel, err := getReleaseChannel(client, project, release.ChannelID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	release.ChannelID = channel.GetID()
+
+	gitRef := ""
+	if release.VersionControlReference != nil {
+		gitRef = release.VersionControlReference.GitRef
+	}
+
+	template, err := getReleaseTemplate(client, project, channel.GetID(), gitRef)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	release.SelectedPackages, err = resolveReleasePackages(client, channel, template, expandReleasePackages(d.Get("package").([]interface{})), release.IgnoreChannelRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(release.Version) == 0 {
+		release.Version = getReleaseVersion(flattenVersioningStrategy(project.VersioningStrategy), template, release.SelectedPackages)
+	}
+
+	log.Printf("[INFO] creating release: %#v", release)
+
+	createdRelease := &versionControlledRelease{Release: &octopusdeploy.Release{}}
+	if err := apiAdd(client.Releases.Sling, client.Releases.BasePath, release, createdRelease); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRelease(ctx, d, createdRelease); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] release created (%s)", d.Id())
+	return nil
+}
+
+func resourceReleaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		log.Printf("[INFO] release (%s) retained; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] deleting release (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Releases.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] release deleted")
+	return nil
+}
+
+func resourceReleaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading release (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	release, err := getRelease(client, d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] release (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setRelease(ctx, d, release); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] release read (%s)", d.Id())
+	return nil
+}
+
+func resourceReleaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating release (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	release, err := getRelease(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only the release notes can be changed; everything else forces a new
+	// release
+	release.ReleaseNotes = d.Get("release_notes").(string)
+
+	updatedRelease := &versionControlledRelease{Release: &octopusdeploy.Release{}}
+	if err := apiUpdate(client.Releases.Sling, client.Releases.BasePath+"/"+d.Id(), release, updatedRelease); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRelease(ctx, d, updatedRelease); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] release updated (%s)", d.Id())
+	return nil
+}
+
+// getReleaseChannel returns the channel of a project that matches the input ID
+// or the default channel of the project if the ID is empty.
+func getReleaseChannel(client *octopusdeploy.Client, project *octopusdeploy.Project, channelID string) (*octopusdeploy.Channel, error) {
+	if len(channelID) > 0 {
+		return client.Channels.GetByID(channelID)
+	}
+
+	channels, err := client.Projects.GetChannels(project)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.IsDefault {
+			return channel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("project (%s) does not have a default channel", project.GetID())
+}
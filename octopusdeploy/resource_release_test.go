@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccReleaseBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_release." + localName
+
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	releaseNotes := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newReleaseNotes := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccReleaseCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccReleaseExists(prefix),
+					resource.TestCheckResourceAttrSet(prefix, "assembled"),
+					resource.TestCheckResourceAttrSet(prefix, "channel_id"),
+					resource.TestCheckResourceAttrPair(prefix, "project_id", "octopusdeploy_project."+localName, "id"),
+					resource.TestCheckResourceAttr(prefix, "release_notes", releaseNotes),
+					resource.TestCheckResourceAttr(prefix, "selected_packages.#", "0"),
+					resource.TestCheckResourceAttr(prefix, "version", "1.0.0"),
+				),
+				Config: testAccReleaseBasic(localName, lifecycleName, projectGroupName, projectName, releaseNotes),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccReleaseExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "release_notes", newReleaseNotes),
+					resource.TestCheckResourceAttr(prefix, "version", "1.0.0"),
+				),
+				Config: testAccReleaseBasic(localName, lifecycleName, projectGroupName, projectName, newReleaseNotes),
+			},
+		},
+	})
+}
+
+func testAccReleaseBasic(localName string, lifecycleName string, projectGroupName string, projectName string, releaseNotes string) string {
+	return fmt.Sprintf(testAccProjectBasic(localName, lifecycleName, localName, projectGroupName, localName, projectName, "")+"\n"+
+		`resource "octopusdeploy_deployment_process" "%s" {
+			project_id = octopusdeploy_project.%s.id
+
+			step {
+				name = "Run a Script"
+
+				run_script_action {
+					name          = "Run a Script"
+					run_on_server = true
+					script_body   = "Write-Host 'hello'"
+				}
+			}
+		}
+
+		resource "octopusdeploy_release" "%s" {
+			delete_on_destroy = true
+			project_id        = octopusdeploy_project.%s.id
+			release_notes     = "%s"
+			version           = "1.0.0"
+
+			depends_on = [octopusdeploy_deployment_process.%s]
+		}`, localName, localName, localName, localName, releaseNotes, localName)
+}
+
+func testAccReleaseExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		releaseID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.Releases.GetByID(releaseID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccReleaseCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_release" {
+			continue
+		}
+
+		release, err := client.Releases.GetByID(rs.Primary.ID)
+		if err == nil && release != nil {
+			return fmt.Errorf("release (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunbook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRunbookCreate,
+		DeleteContext: resourceRunbookDelete,
+		Description:   "This resource manages runbooks in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceRunbookRead,
+		Schema:        getRunbookSchema(),
+		UpdateContext: resourceRunbookUpdate,
+	}
+}
+
+func resourceRunbookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	runbook := expandRunbook(d)
+
+	log.Printf("[INFO] creating runbook: %#v", runbook)
+
+	client := m.(*octopusdeploy.Client)
+	createdRunbook, err := client.Runbooks.Add(runbook)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbook(ctx, d, createdRunbook); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdRunbook.GetID())
+
+	log.Printf("[INFO] runbook created (%s)", d.Id())
+	return nil
+}
+
+func resourceRunbookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting runbook (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Runbooks.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] runbook deleted")
+	return nil
+}
+
+func resourceRunbookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading runbook (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	runbook, err := client.Runbooks.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] runbook (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbook(ctx, d, runbook); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] runbook read (%s)", d.Id())
+	return nil
+}
+
+func resourceRunbookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating runbook (%s)", d.Id())
+
+	runbook := expandRunbook(d)
+	client := m.(*octopusdeploy.Client)
+	current, err := client.Runbooks.GetByID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the runbook process and published snapshot are managed by the server
+	runbook.PublishedRunbookSnapshotID = current.PublishedRunbookSnapshotID
+	runbook.RunbookProcessID = current.RunbookProcessID
+
+	updatedRunbook, err := client.Runbooks.Update(runbook)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbook(ctx, d, updatedRunbook); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] runbook updated (%s)", d.Id())
+	return nil
+}
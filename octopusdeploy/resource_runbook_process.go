@@ -0,0 +1,140 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunbookProcess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRunbookProcessCreate,
+		DeleteContext: resourceRunbookProcessDelete,
+		Description:   "This resource manages runbook processes in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceRunbookProcessRead,
+		Schema:        getRunbookProcessSchema(),
+		UpdateContext: resourceRunbookProcessUpdate,
+	}
+}
+
+func resourceRunbookProcessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	runbookProcess := expandRunbookProcess(d)
+
+	log.Printf("[INFO] creating runbook process: %#v", runbookProcess)
+
+	// every runbook is created with an (empty) runbook process; creating this
+	// resource replaces the steps of that process
+	client := m.(*octopusdeploy.Client)
+	runbook, err := client.Runbooks.GetByID(runbookProcess.RunbookID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runbookProcess.ID = runbook.RunbookProcessID
+
+	updatedRunbookProcess, err := updateRunbookProcess(client, runbookProcess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbookProcess(ctx, d, updatedRunbookProcess); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] runbook process created (%s)", d.Id())
+	return nil
+}
+
+func resourceRunbookProcessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting runbook process (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	runbookProcess := octopusdeploy.NewRunbookProcess()
+	runbookProcess.ID = d.Id()
+	runbookProcess.RunbookID = d.Get("runbook_id").(string)
+	runbookProcess.Steps = []*octopusdeploy.DeploymentStep{}
+
+	if _, err := updateRunbookProcess(client, runbookProcess); err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if !ok || apiError.StatusCode != 404 {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] runbook process deleted")
+	return nil
+}
+
+func resourceRunbookProcessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading runbook process (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	runbookProcess, err := client.RunbookProcesses.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] runbook process (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbookProcess(ctx, d, runbookProcess); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] runbook process read (%s)", d.Id())
+	return nil
+}
+
+func resourceRunbookProcessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating runbook process (%s)", d.Id())
+
+	runbookProcess := expandRunbookProcess(d)
+	client := m.(*octopusdeploy.Client)
+	updatedRunbookProcess, err := updateRunbookProcess(client, runbookProcess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbookProcess(ctx, d, updatedRunbookProcess); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] runbook process updated (%s)", d.Id())
+	return nil
+}
+
+// updateRunbookProcess replaces the steps of a runbook process. The runbook
+// process service of the client does not support updates, so the request is
+// issued directly against the API using the current version of the process.
+func updateRunbookProcess(client *octopusdeploy.Client, runbookProcess *octopusdeploy.RunbookProcess) (*octopusdeploy.RunbookProcess, error) {
+	current, err := client.RunbookProcesses.GetByID(runbookProcess.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	runbookProcess.LastSnapshotID = current.LastSnapshotID
+	runbookProcess.ProjectID = current.ProjectID
+	runbookProcess.SpaceID = current.SpaceID
+	runbookProcess.Version = current.Version
+
+	path, err := client.RunbookProcesses.URITemplate.Expand(map[string]interface{}{"id": runbookProcess.GetID()})
+	if err != nil {
+		return nil, err
+	}
+
+	updatedRunbookProcess := octopusdeploy.NewRunbookProcess()
+	if err := apiUpdate(client.RunbookProcesses.Sling, path, runbookProcess, updatedRunbookProcess); err != nil {
+		return nil, err
+	}
+
+	return updatedRunbookProcess, nil
+}
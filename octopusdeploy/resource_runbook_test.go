@@ -0,0 +1,144 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRunbookBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_runbook." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccRunbookCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccRunbookExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "default_guided_failure_mode", "EnvironmentDefault"),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "environment_scope", "All"),
+					resource.TestCheckResourceAttr(prefix, "multi_tenancy_mode", "Untenanted"),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttrSet(prefix, "project_id"),
+					resource.TestCheckResourceAttr(prefix, "run_retention_policy.0.quantity_to_keep", "10"),
+					resource.TestCheckResourceAttrSet(prefix, "runbook_process_id"),
+				),
+				Config: testAccRunbookBasic(localName, name, description, "EnvironmentDefault"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccRunbookExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "default_guided_failure_mode", "On"),
+				),
+				Config: testAccRunbookBasic(localName, name, description, "On"),
+			},
+		},
+	})
+}
+
+func TestAccRunbookProcessBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_runbook_process." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccRunbookCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(prefix, "id", "octopusdeploy_runbook."+localName, "runbook_process_id"),
+					resource.TestCheckResourceAttrSet(prefix, "runbook_id"),
+					resource.TestCheckResourceAttr(prefix, "step.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "step.0.name", "Run a Script"),
+					resource.TestCheckResourceAttr(prefix, "step.0.run_script_action.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "step.0.run_script_action.0.run_on_server", "true"),
+				),
+				Config: testAccRunbookProcessBasic(localName, name, description),
+			},
+		},
+	})
+}
+
+func testAccRunbookBasic(localName string, name string, description string, guidedFailureMode string) string {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, projectDescription)+"\n"+
+		`resource "octopusdeploy_runbook" "%s" {
+			default_guided_failure_mode = "%s"
+			description                 = "%s"
+			name                        = "%s"
+			project_id                  = octopusdeploy_project.%s.id
+
+			run_retention_policy {
+				quantity_to_keep = 10
+			}
+		}`, localName, guidedFailureMode, description, name, projectLocalName)
+}
+
+func testAccRunbookProcessBasic(localName string, name string, description string) string {
+	return fmt.Sprintf(testAccRunbookBasic(localName, name, description, "EnvironmentDefault")+"\n"+
+		`resource "octopusdeploy_runbook_process" "%s" {
+			runbook_id = octopusdeploy_runbook.%s.id
+
+			step {
+				condition     = "Success"
+				name          = "Run a Script"
+				start_trigger = "StartAfterPrevious"
+
+				run_script_action {
+					name          = "Run a Script"
+					run_on_server = true
+					script_body   = "Write-Host 'Hello world'"
+				}
+			}
+		}`, localName, localName)
+}
+
+func testAccRunbookExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		runbookID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.Runbooks.GetByID(runbookID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccRunbookCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_runbook" {
+			continue
+		}
+
+		runbook, err := client.Runbooks.GetByID(rs.Primary.ID)
+		if err == nil && runbook != nil {
+			return fmt.Errorf("runbook (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceS3Feed() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceS3FeedCreate,
+		DeleteContext: resourceS3FeedDelete,
+		Description:   "This resource manages an S3 feed in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceS3FeedRead,
+		Schema:        getS3FeedSchema(),
+		UpdateContext: resourceS3FeedUpdate,
+	}
+}
+
+func resourceS3FeedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandS3Feed(d)
+
+	log.Printf("[INFO] creating S3 feed: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	createdFeed, err := addExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setS3Feed(ctx, d, createdFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] S3 feed created (%s)", d.Id())
+	return nil
+}
+
+func resourceS3FeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting S3 feed (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Feeds.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] S3 feed deleted")
+	return nil
+}
+
+func resourceS3FeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading S3 feed (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	feed, err := getExtendedFeedResource(client, d.Id(), feedTypeS3)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] S3 feed (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setS3Feed(ctx, d, feed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] S3 feed read (%s)", d.Id())
+	return nil
+}
+
+func resourceS3FeedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feed := expandS3Feed(d)
+
+	log.Printf("[INFO] updating S3 feed: %#v", feed)
+
+	client := m.(*octopusdeploy.Client)
+	updatedFeed, err := updateExtendedFeedResource(client, feed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setS3Feed(ctx, d, updatedFeed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] S3 feed updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,90 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOctopusDeployS3Feed(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_s3_feed." + localName
+
+	accessKey := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	secretKey := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testS3FeedCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testS3FeedExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "access_key", accessKey),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "secret_key", secretKey),
+					resource.TestCheckResourceAttr(prefix, "use_machine_credentials", "false"),
+				),
+				Config: testS3FeedBasic(localName, name, accessKey, secretKey),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testS3FeedExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "access_key", ""),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "use_machine_credentials", "true"),
+				),
+				Config: testS3FeedMachineCredentials(localName, name),
+			},
+		},
+	})
+}
+
+func testS3FeedBasic(localName string, name string, accessKey string, secretKey string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_s3_feed" "%s" {
+		access_key = "%s"
+		name       = "%s"
+		secret_key = "%s"
+	}`, localName, accessKey, name, secretKey)
+}
+
+func testS3FeedMachineCredentials(localName string, name string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_s3_feed" "%s" {
+		name                    = "%s"
+		use_machine_credentials = true
+	}`, localName, name)
+}
+
+func testS3FeedExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		feedID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getExtendedFeedResource(client, feedID, feedTypeS3); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testS3FeedCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_s3_feed" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		feed, err := client.Feeds.GetByID(rs.Primary.ID)
+		if err == nil && feed != nil {
+			return fmt.Errorf("S3 feed (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceScopedUserRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScopedUserRoleCreate,
+		DeleteContext: resourceScopedUserRoleDelete,
+		Description:   "This resource manages scoped user roles in Octopus Deploy.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceScopedUserRoleImport},
+		ReadContext:   resourceScopedUserRoleRead,
+		Schema:        getScopedUserRoleSchema(),
+		UpdateContext: resourceScopedUserRoleUpdate,
+	}
+}
+
+// resourceScopedUserRoleImport imports a scoped user role by its ID or by the
+// IDs of its team and user role (e.g. Teams-123:UserRoles-123).
+func resourceScopedUserRoleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing scoped user role (%s)", d.Id())
+
+	importStrings := strings.Split(d.Id(), ":")
+	if len(importStrings) == 1 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_scoped_user_role import must be in the form of ScopedUserRoleID or TeamID:UserRoleID (e.g. Teams-123:UserRoles-123)")
+	}
+
+	teamID := importStrings[0]
+	userRoleID := importStrings[1]
+
+	client := m.(*octopusdeploy.Client)
+	path, err := client.Teams.URITemplate.Expand(map[string]interface{}{"id": teamID})
+	if err != nil {
+		return nil, err
+	}
+	path += "/scopeduserroles"
+
+	matchingScopedUserRoles := []*octopusdeploy.ScopedUserRole{}
+	for loadNextPage := true; loadNextPage; {
+		scopedUserRoles := &octopusdeploy.ScopedUserRoles{}
+		if err := apiGet(client.Teams.Sling, path, scopedUserRoles); err != nil {
+			return nil, err
+		}
+
+		for _, scopedUserRole := range scopedUserRoles.Items {
+			if scopedUserRole.UserRoleID == userRoleID {
+				matchingScopedUserRoles = append(matchingScopedUserRoles, scopedUserRole)
+			}
+		}
+
+		path, loadNextPage = octopusdeploy.LoadNextPage(scopedUserRoles.PagedResults)
+	}
+
+	switch len(matchingScopedUserRoles) {
+	case 0:
+		return nil, fmt.Errorf("user role (%s) is not granted to team (%s)", userRoleID, teamID)
+	case 1:
+		d.SetId(matchingScopedUserRoles[0].GetID())
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf("user role (%s) is granted to team (%s) more than once; import the scoped user role by its ID instead", userRoleID, teamID)
+	}
+}
+
+func resourceScopedUserRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	scopedUserRole := expandScopedUserRole(d)
+
+	log.Printf("[INFO] creating scoped user role: %#v", scopedUserRole)
+
+	client := m.(*octopusdeploy.Client)
+	createdScopedUserRole, err := client.ScopedUserRoles.Add(scopedUserRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setScopedUserRole(ctx, d, createdScopedUserRole); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdScopedUserRole.GetID())
+
+	log.Printf("[INFO] scoped user role created (%s)", d.Id())
+	return nil
+}
+
+func resourceScopedUserRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting scoped user role (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.ScopedUserRoles.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] scoped user role deleted")
+	return nil
+}
+
+func resourceScopedUserRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading scoped user role (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	scopedUserRole, err := client.ScopedUserRoles.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] scoped user role (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setScopedUserRole(ctx, d, scopedUserRole); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] scoped user role read (%s)", d.Id())
+	return nil
+}
+
+func resourceScopedUserRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating scoped user role (%s)", d.Id())
+
+	scopedUserRole := expandScopedUserRole(d)
+	client := m.(*octopusdeploy.Client)
+	updatedScopedUserRole, err := client.ScopedUserRoles.Update(scopedUserRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setScopedUserRole(ctx, d, updatedScopedUserRole); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] scoped user role updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,108 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccScopedUserRoleBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_scoped_user_role." + localName
+
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	teamName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	userRoleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccScopedUserRoleCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccScopedUserRoleExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "environment_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(prefix, "team_id", "octopusdeploy_team."+localName, "id"),
+					resource.TestCheckResourceAttrPair(prefix, "user_role_id", "octopusdeploy_user_role."+localName, "id"),
+				),
+				Config: testAccScopedUserRoleBasic(localName, environmentName, teamName, userRoleName, false),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccScopedUserRoleExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "environment_ids.#", "1"),
+				),
+				Config: testAccScopedUserRoleBasic(localName, environmentName, teamName, userRoleName, true),
+			},
+			{
+				ImportState:       true,
+				ImportStateIdFunc: testAccScopedUserRoleImportStateIDFunc(prefix),
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func testAccScopedUserRoleBasic(localName string, environmentName string, teamName string, userRoleName string, isScoped bool) string {
+	environmentIDs := "[]"
+	if isScoped {
+		environmentIDs = fmt.Sprintf("[octopusdeploy_environment.%s.id]", localName)
+	}
+
+	return fmt.Sprintf(testEnvironmentMinimum(localName, environmentName)+"\n"+
+		testAccTeamBasic(localName, teamName, "")+"\n"+
+		testUserRolePermissions(localName, userRoleName)+"\n"+
+		`resource "octopusdeploy_scoped_user_role" "%s" {
+			environment_ids = %s
+			space_id        = octopusdeploy_environment.%s.space_id
+			team_id         = octopusdeploy_team.%s.id
+			user_role_id    = octopusdeploy_user_role.%s.id
+		}`, localName, environmentIDs, localName, localName, localName)
+}
+
+// testAccScopedUserRoleImportStateIDFunc imports the scoped user role by the
+// IDs of its team and user role.
+func testAccScopedUserRoleImportStateIDFunc(prefix string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[prefix]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", prefix)
+		}
+
+		return rs.Primary.Attributes["team_id"] + ":" + rs.Primary.Attributes["user_role_id"], nil
+	}
+}
+
+func testAccScopedUserRoleExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		scopedUserRoleID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.ScopedUserRoles.GetByID(scopedUserRoleID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccScopedUserRoleCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_scoped_user_role" {
+			continue
+		}
+
+		scopedUserRole, err := client.ScopedUserRoles.GetByID(rs.Primary.ID)
+		if err == nil && scopedUserRole != nil {
+			return fmt.Errorf("scoped user role (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccScriptModuleBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_script_module." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccScriptModuleCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccScriptModuleExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "script_body", "function Say-Hello { Write-Host 'Hello' }"),
+					resource.TestCheckResourceAttr(prefix, "script_syntax", "PowerShell"),
+					resource.TestCheckResourceAttrSet(prefix, "variable_set_id"),
+				),
+				Config: testAccScriptModuleBasic(localName, name, description, "function Say-Hello { Write-Host 'Hello' }", "PowerShell"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccScriptModuleExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "name", newName),
+					resource.TestCheckResourceAttr(prefix, "script_body", "say_hello() { echo 'Hello'; }"),
+					resource.TestCheckResourceAttr(prefix, "script_syntax", "Bash"),
+				),
+				Config: testAccScriptModuleBasic(localName, newName, description, "say_hello() { echo 'Hello'; }", "Bash"),
+			},
+			{
+				ResourceName:      prefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccScriptModuleIncludedInProject(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_project." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccScriptModuleCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "included_library_variable_sets.#", "1"),
+					resource.TestCheckResourceAttrPair(prefix, "included_library_variable_sets.0", "octopusdeploy_script_module."+localName, "id"),
+				),
+				Config: testAccScriptModuleIncludedInProject(localName, name),
+			},
+		},
+	})
+}
+
+func testAccScriptModuleBasic(localName string, name string, description string, body string, syntax string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_script_module" "%s" {
+		description   = "%s"
+		name          = "%s"
+		script_body   = "%s"
+		script_syntax = "%s"
+	}`, localName, description, name, body, syntax)
+}
+
+func testAccScriptModuleIncludedInProject(localName string, name string) string {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccLifecycleBasic(lifecycleLocalName, lifecycleName)+"\n"+
+		testAccProjectGroupBasic(projectGroupLocalName, projectGroupName)+"\n"+
+		testAccScriptModuleBasic(localName, name, "", "function Say-Hello { Write-Host 'Hello' }", "PowerShell")+"\n"+
+		`resource "octopusdeploy_project" "%s" {
+			included_library_variable_sets = [octopusdeploy_script_module.%s.id]
+			lifecycle_id                   = octopusdeploy_lifecycle.%s.id
+			name                           = "%s"
+			project_group_id               = octopusdeploy_project_group.%s.id
+		}`, localName, localName, lifecycleLocalName, projectName, projectGroupLocalName)
+}
+
+func testAccScriptModuleExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		scriptModuleID := s.RootModule().Resources[prefix].Primary.ID
+		scriptModule, err := client.LibraryVariableSets.GetByID(scriptModuleID)
+		if err != nil {
+			return err
+		}
+
+		if scriptModule.ContentType != "ScriptModule" {
+			return fmt.Errorf("library variable set (%s) is not a script module", scriptModuleID)
+		}
+
+		return nil
+	}
+}
+
+func testAccScriptModuleCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_script_module" {
+			continue
+		}
+
+		scriptModule, err := client.LibraryVariableSets.GetByID(rs.Primary.ID)
+		if err == nil && scriptModule != nil {
+			return fmt.Errorf("script module (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
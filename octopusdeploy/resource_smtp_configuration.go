@@ -0,0 +1,82 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSMTPConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSMTPConfigurationCreate,
+		DeleteContext: resourceSMTPConfigurationDelete,
+		Description:   "This resource manages the SMTP settings of the Octopus server. The settings are a singleton; they are adopted on creation and reset to their defaults on deletion.",
+		Importer:      getImporter(),
+		ReadContext:   resourceSMTPConfigurationRead,
+		Schema:        getSMTPConfigurationSchema(),
+		UpdateContext: resourceSMTPConfigurationUpdate,
+	}
+}
+
+func resourceSMTPConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the SMTP configuration always exists; it is adopted and overwritten
+	log.Printf("[INFO] adopting SMTP configuration")
+
+	if err := resourceSMTPConfigurationUpdate(ctx, d, m); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] SMTP configuration adopted (%s)", d.Id())
+	return nil
+}
+
+func resourceSMTPConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] resetting SMTP configuration (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if _, err := updateSMTPConfiguration(client, newDefaultSMTPConfiguration()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] SMTP configuration reset")
+	return nil
+}
+
+func resourceSMTPConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading SMTP configuration (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	configuration, err := getSMTPConfiguration(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSMTPConfiguration(ctx, d, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] SMTP configuration read (%s)", d.Id())
+	return nil
+}
+
+func resourceSMTPConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating SMTP configuration")
+
+	client := m.(*octopusdeploy.Client)
+	updatedConfiguration, err := updateSMTPConfiguration(client, expandSMTPConfiguration(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSMTPConfiguration(ctx, d, updatedConfiguration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] SMTP configuration updated (%s)", d.Id())
+	return nil
+}
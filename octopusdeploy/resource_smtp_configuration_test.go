@@ -0,0 +1,67 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSMTPConfigurationBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_smtp_configuration." + localName
+
+	host := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha) + ".example.com"
+	newHost := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha) + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccSMTPConfigurationCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "enable_ssl", "true"),
+					resource.TestCheckResourceAttr(prefix, "host", host),
+					resource.TestCheckResourceAttr(prefix, "port", "587"),
+					resource.TestCheckResourceAttr(prefix, "username", "octopus"),
+				),
+				Config: testAccSMTPConfigurationBasic(localName, host),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "host", newHost),
+				),
+				Config: testAccSMTPConfigurationBasic(localName, newHost),
+			},
+		},
+	})
+}
+
+func testAccSMTPConfigurationBasic(localName string, host string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_smtp_configuration" "%s" {
+		enable_ssl      = true
+		host            = "%s"
+		password        = "password"
+		port            = 587
+		send_email_from = "octopus@example.com"
+		username        = "octopus"
+	}`, localName, host)
+}
+
+func testAccSMTPConfigurationCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	configuration, err := getSMTPConfiguration(client)
+	if err != nil {
+		return err
+	}
+
+	if len(configuration.SmtpHost) > 0 {
+		return fmt.Errorf("SMTP configuration (%s) was not reset", configuration.SmtpHost)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSSHConnectionWorker() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSHConnectionWorkerCreate,
+		DeleteContext: resourceSSHConnectionWorkerDelete,
+		Description:   "This resource manages SSH connection workers in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceSSHConnectionWorkerRead,
+		Schema:        getSSHConnectionWorkerSchema(),
+		UpdateContext: resourceSSHConnectionWorkerUpdate,
+	}
+}
+
+func resourceSSHConnectionWorkerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	worker := expandSSHConnectionWorker(d)
+
+	log.Printf("[INFO] creating SSH connection worker: %#v", worker)
+
+	client := m.(*octopusdeploy.Client)
+	createdWorker, err := client.Workers.Add(worker)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSSHConnectionWorker(ctx, d, createdWorker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdWorker.GetID())
+
+	log.Printf("[INFO] SSH connection worker created (%s)", d.Id())
+	return nil
+}
+
+func resourceSSHConnectionWorkerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting SSH connection worker (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Workers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] SSH connection worker deleted")
+	return nil
+}
+
+func resourceSSHConnectionWorkerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading SSH connection worker (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	worker, err := client.Workers.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] SSH connection worker (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setSSHConnectionWorker(ctx, d, worker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] SSH connection worker read (%s)", d.Id())
+	return nil
+}
+
+func resourceSSHConnectionWorkerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating SSH connection worker (%s)", d.Id())
+
+	worker := expandSSHConnectionWorker(d)
+	client := m.(*octopusdeploy.Client)
+	updatedWorker, err := client.Workers.Update(worker)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSSHConnectionWorker(ctx, d, updatedWorker); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] SSH connection worker updated (%s)", d.Id())
+	return nil
+}
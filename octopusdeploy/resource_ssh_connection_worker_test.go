@@ -0,0 +1,100 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSSHConnectionWorkerBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_ssh_connection_worker." + localName
+
+	fingerprint := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccSSHConnectionWorkerCheckDestroy,
+			testAccAccountCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHConnectionWorkerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "octopusdeploy_username_password_account."+localName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "dot_net_core_platform", "linux-x64"),
+					resource.TestCheckResourceAttr(resourceName, "fingerprint", fingerprint),
+					resource.TestCheckResourceAttr(resourceName, "host", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "machine_policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "worker_pool_ids.#", "1"),
+				),
+				Config: testAccSSHConnectionWorkerBasic(localName, name, fingerprint, 22),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHConnectionWorkerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port", "2222"),
+				),
+				Config: testAccSSHConnectionWorkerBasic(localName, name, fingerprint, 2222),
+			},
+		},
+	})
+}
+
+func testAccSSHConnectionWorkerBasic(localName string, name string, fingerprint string, port int) string {
+	username := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(`data "octopusdeploy_machine_policies" "default" {
+		partial_name = "Default Machine Policy"
+	}`+"\n"+
+		testStaticWorkerPoolBasic(localName, name)+"\n"+
+		testUsernamePasswordMinimum(localName, name, username)+"\n"+`
+	resource "octopusdeploy_ssh_connection_worker" "%s" {
+		account_id            = octopusdeploy_username_password_account.%s.id
+		dot_net_core_platform = "linux-x64"
+		fingerprint           = "%s"
+		host                  = "example.com"
+		machine_policy_id     = "${data.octopusdeploy_machine_policies.default.machine_policies[0].id}"
+		name                  = "%s"
+		port                  = %d
+		worker_pool_ids       = ["${octopusdeploy_worker_pool.%s.id}"]
+	}`, localName, localName, fingerprint, name, port, localName)
+}
+
+func testAccSSHConnectionWorkerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		workerID := s.RootModule().Resources[resourceName].Primary.ID
+		if _, err := client.Workers.GetByID(workerID); err != nil {
+			return fmt.Errorf("error retrieving worker: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccSSHConnectionWorkerCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_ssh_connection_worker" {
+			continue
+		}
+
+		_, err := client.Workers.GetByID(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("worker (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceStepTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStepTemplateCreate,
+		DeleteContext: resourceStepTemplateDelete,
+		Description:   "This resource manages step templates in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceStepTemplateRead,
+		Schema:        getStepTemplateSchema(),
+		UpdateContext: resourceStepTemplateUpdate,
+	}
+}
+
+func resourceStepTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	stepTemplate := expandStepTemplate(d)
+
+	log.Printf("[INFO] creating step template: %#v", stepTemplate)
+
+	client := m.(*octopusdeploy.Client)
+	createdStepTemplate, err := client.ActionTemplates.Add(stepTemplate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setStepTemplate(ctx, d, createdStepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdStepTemplate.GetID())
+
+	log.Printf("[INFO] step template created (%s)", d.Id())
+	return nil
+}
+
+func resourceStepTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting step template (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.ActionTemplates.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] step template deleted")
+	return nil
+}
+
+func resourceStepTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading step template (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	stepTemplate, err := client.ActionTemplates.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] step template (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setStepTemplate(ctx, d, stepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] step template read (%s)", d.Id())
+	return nil
+}
+
+func resourceStepTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating step template (%s)", d.Id())
+
+	stepTemplate := expandStepTemplate(d)
+	client := m.(*octopusdeploy.Client)
+	updatedStepTemplate, err := client.ActionTemplates.Update(stepTemplate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setStepTemplate(ctx, d, updatedStepTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] step template updated (%s)", d.Id())
+	return nil
+}
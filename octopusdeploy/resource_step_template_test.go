@@ -0,0 +1,117 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccStepTemplateBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_step_template." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccStepTemplateCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccStepTemplateExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "action_type", "Octopus.Script"),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "parameter.#", "1"),
+					resource.TestCheckResourceAttr(prefix, "parameter.0.name", "Greeting"),
+					resource.TestCheckResourceAttr(prefix, "script_body", "Write-Host 'Hello world'"),
+					resource.TestCheckResourceAttr(prefix, "script_syntax", "PowerShell"),
+					resource.TestCheckResourceAttrSet(prefix, "version"),
+				),
+				Config: testAccStepTemplateBasic(localName, name, description, "Write-Host 'Hello world'"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccStepTemplateExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "script_body", "Write-Host 'Hello again'"),
+				),
+				Config: testAccStepTemplateBasic(localName, name, description, "Write-Host 'Hello again'"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_step_templates."+localName, "step_templates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_step_templates."+localName, "step_templates.0.id", prefix, "id"),
+				),
+				Config: testAccStepTemplateBasic(localName, name, description, "Write-Host 'Hello again'") + "\n" + testAccStepTemplatesDataSource(localName),
+			},
+			{
+				ResourceName:      prefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStepTemplateBasic(localName string, name string, description string, scriptBody string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_step_template" "%s" {
+		action_type = "Octopus.Script"
+		description = "%s"
+		name        = "%s"
+		script_body = "%s"
+
+		parameter {
+			default_value = "Hello"
+			label         = "Greeting"
+			name          = "Greeting"
+
+			display_settings = {
+				"Octopus.ControlType" = "SingleLineText"
+			}
+		}
+
+		properties = {
+			"Octopus.Action.RunOnServer" = "true"
+		}
+	}`, localName, description, name, scriptBody)
+}
+
+func testAccStepTemplatesDataSource(localName string) string {
+	return fmt.Sprintf(`data "octopusdeploy_step_templates" "%s" {
+		ids = [octopusdeploy_step_template.%s.id]
+	}`, localName, localName)
+}
+
+func testAccStepTemplateExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		stepTemplateID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.ActionTemplates.GetByID(stepTemplateID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccStepTemplateCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_step_template" {
+			continue
+		}
+
+		stepTemplate, err := client.ActionTemplates.GetByID(rs.Primary.ID)
+		if err == nil && stepTemplate != nil {
+			return fmt.Errorf("step template (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSubscriptionCreate,
+		CustomizeDiff: resourceSubscriptionCustomizeDiff,
+		DeleteContext: resourceSubscriptionDelete,
+		Description:   "This resource manages subscriptions (event notifications) in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceSubscriptionRead,
+		Schema:        getSubscriptionSchema(),
+		UpdateContext: resourceSubscriptionUpdate,
+	}
+}
+
+// resourceSubscriptionCustomizeDiff validates the event groups and categories
+// against the ones that are supported by the server.
+func resourceSubscriptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client := m.(*octopusdeploy.Client)
+
+	for key, path := range map[string]string{
+		"event_categories": "/api/events/categories",
+		"event_groups":     "/api/events/groups",
+	} {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			continue
+		}
+
+		values := getSliceFromTerraformTypeList(d.Get(key))
+		if len(values) == 0 {
+			continue
+		}
+
+		validValues, err := getEventFilterValues(client, path)
+		if err != nil {
+			return err
+		}
+
+		if err := validateEventFilter(key, values, validValues); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getEventFilterValues(client *octopusdeploy.Client, path string) ([]string, error) {
+	items := []struct {
+		ID string `json:"Id"`
+	}{}
+	if err := apiGet(client.Events.Sling, path, &items); err != nil {
+		return nil, err
+	}
+
+	values := []string{}
+	for _, item := range items {
+		values = append(values, item.ID)
+	}
+
+	return values, nil
+}
+
+func resourceSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	subscription, err := expandSubscription(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] creating subscription: %#v", subscription)
+
+	client := m.(*octopusdeploy.Client)
+	createdSubscription := &eventSubscription{}
+	if err := apiAdd(client.Subscriptions.Sling, client.Subscriptions.BasePath, subscription, createdSubscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSubscription(ctx, d, createdSubscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] subscription created (%s)", d.Id())
+	return nil
+}
+
+func resourceSubscriptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting subscription (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	if err := client.Subscriptions.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] subscription deleted")
+	return nil
+}
+
+func resourceSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading subscription (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	subscription, err := getSubscription(client, d.Id())
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] subscription (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setSubscription(ctx, d, subscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] subscription read (%s)", d.Id())
+	return nil
+}
+
+func resourceSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating subscription (%s)", d.Id())
+
+	subscription, err := expandSubscription(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	currentSubscription, err := getSubscription(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the server tracks which events have been sent through the subscription;
+	// these markers are kept to avoid sending the same events again
+	notification := subscription.EventNotificationSubscription
+	notification.EmailDigestLastProcessed = currentSubscription.EventNotificationSubscription.EmailDigestLastProcessed
+	notification.EmailDigestLastProcessedEventAutoID = currentSubscription.EventNotificationSubscription.EmailDigestLastProcessedEventAutoID
+	notification.WebhookLastProcessed = currentSubscription.EventNotificationSubscription.WebhookLastProcessed
+	notification.WebhookLastProcessedEventAutoID = currentSubscription.EventNotificationSubscription.WebhookLastProcessedEventAutoID
+
+	path, err := client.Subscriptions.URITemplate.Expand(map[string]interface{}{"id": d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedSubscription := &eventSubscription{}
+	if err := apiUpdate(client.Subscriptions.Sling, path, subscription, updatedSubscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSubscription(ctx, d, updatedSubscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] subscription updated (%s)", d.Id())
+	return nil
+}
+
+func getSubscription(client *octopusdeploy.Client, id string) (*eventSubscription, error) {
+	path, err := client.Subscriptions.URITemplate.Expand(map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &eventSubscription{}
+	if err := apiGet(client.Subscriptions.Sling, path, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
@@ -0,0 +1,118 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSubscriptionBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_subscription." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	teamName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccSubscriptionCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubscriptionWebhook(localName, name, "NotAnEventGroup", "10s"),
+				ExpectError: regexp.MustCompile("Invalid value for event_groups"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccSubscriptionExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "event_groups.0", "DeploymentCritical"),
+					resource.TestCheckResourceAttr(prefix, "webhook.0.timeout", "10s"),
+					resource.TestCheckResourceAttr(prefix, "webhook.0.uri", "https://example.com/octopus"),
+				),
+				Config: testAccSubscriptionWebhook(localName, name, "DeploymentCritical", "10s"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccSubscriptionExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "webhook.0.timeout", "1m0s"),
+				),
+				Config: testAccSubscriptionWebhook(localName, name, "DeploymentCritical", "1m"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccSubscriptionExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "email.0.digest_frequency", "2h0m0s"),
+					resource.TestCheckResourceAttr(prefix, "email.0.priority", "High"),
+					resource.TestCheckResourceAttrPair(prefix, "email.0.team_ids.0", "octopusdeploy_team."+localName, "id"),
+					resource.TestCheckResourceAttr(prefix, "webhook.#", "0"),
+				),
+				Config: testAccSubscriptionEmail(localName, name, teamName),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func testAccSubscriptionWebhook(localName string, name string, eventGroup string, timeout string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_subscription" "%s" {
+		event_groups = ["%s"]
+		name         = "%s"
+
+		webhook {
+			timeout = "%s"
+			uri     = "https://example.com/octopus"
+		}
+	}`, localName, eventGroup, name, timeout)
+}
+
+func testAccSubscriptionEmail(localName string, name string, teamName string) string {
+	return fmt.Sprintf(testAccTeamBasic(localName, teamName, "")+"\n"+
+		`resource "octopusdeploy_subscription" "%s" {
+			event_categories = ["DeploymentFailed"]
+			name             = "%s"
+
+			email {
+				digest_frequency = "2h"
+				priority         = "High"
+				team_ids         = [octopusdeploy_team.%s.id]
+			}
+		}`, localName, name, localName)
+}
+
+func testAccSubscriptionExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		subscriptionID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := getSubscription(client, subscriptionID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccSubscriptionCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_subscription" {
+			continue
+		}
+
+		subscription, err := getSubscription(client, rs.Primary.ID)
+		if err == nil && subscription != nil {
+			return fmt.Errorf("subscription (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
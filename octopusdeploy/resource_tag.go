@@ -0,0 +1,173 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTagCreate,
+		DeleteContext: resourceTagDelete,
+		Description:   "This resource manages a tag of an existing tag set in Octopus Deploy. Other tags of the tag set are left untouched.",
+		Importer:      getImporter(),
+		ReadContext:   resourceTagRead,
+		Schema:        getTagSchema(),
+		UpdateContext: resourceTagUpdate,
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tagSetMutex.Lock()
+	defer tagSetMutex.Unlock()
+
+	tagSetID := d.Get("tag_set_id").(string)
+	tag := expandTagResource(d, "")
+
+	log.Printf("[INFO] creating tag (%s) in tag set (%s)", tag.Name, tagSetID)
+
+	client := m.(*octopusdeploy.Client)
+	tagSet, err := client.TagSets.GetByID(tagSetID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isNamed := func(t octopusdeploy.Tag) bool { return t.Name == tag.Name }
+	if findTag(tagSet.Tags, isNamed) >= 0 {
+		return diag.Errorf("tag set (%s) already has a tag named %s; import the tag to manage it", tagSetID, tag.Name)
+	}
+
+	tagSet.Tags = append(tagSet.Tags, tag)
+	updatedTagSet, err := client.TagSets.Update(tagSet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	i := findTag(updatedTagSet.Tags, isNamed)
+	if i < 0 {
+		return diag.Errorf("tag (%s) was not added to tag set (%s)", tag.Name, tagSetID)
+	}
+
+	if err := setTag(ctx, d, tagSetID, &updatedTagSet.Tags[i]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tag created (%s)", d.Id())
+	return nil
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tagSetMutex.Lock()
+	defer tagSetMutex.Unlock()
+
+	log.Printf("[INFO] deleting tag (%s)", d.Id())
+
+	tagSetID, tagID, err := parseTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	tagSet, err := client.TagSets.GetByID(tagSetID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if i := findTag(tagSet.Tags, func(t octopusdeploy.Tag) bool { return t.ID == tagID }); i >= 0 {
+		tagSet.Tags = append(tagSet.Tags[:i], tagSet.Tags[i+1:]...)
+		if _, err := client.TagSets.Update(tagSet); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] tag deleted")
+	return nil
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading tag (%s)", d.Id())
+
+	tagSetID, tagID, err := parseTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	tagSet, err := client.TagSets.GetByID(tagSetID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] tag set (%s) not found; deleting tag from state", tagSetID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	i := findTag(tagSet.Tags, func(t octopusdeploy.Tag) bool { return t.ID == tagID })
+	if i < 0 {
+		log.Printf("[INFO] tag (%s) not found; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := setTag(ctx, d, tagSetID, &tagSet.Tags[i]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tag read (%s)", d.Id())
+	return nil
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tagSetMutex.Lock()
+	defer tagSetMutex.Unlock()
+
+	log.Printf("[INFO] updating tag (%s)", d.Id())
+
+	tagSetID, tagID, err := parseTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	tagSet, err := client.TagSets.GetByID(tagSetID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isTag := func(t octopusdeploy.Tag) bool { return t.ID == tagID }
+	i := findTag(tagSet.Tags, isTag)
+	if i < 0 {
+		return diag.Errorf("tag (%s) not found in tag set (%s)", tagID, tagSetID)
+	}
+	tagSet.Tags[i] = expandTagResource(d, tagID)
+
+	updatedTagSet, err := client.TagSets.Update(tagSet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	i = findTag(updatedTagSet.Tags, isTag)
+	if i < 0 {
+		return diag.Errorf("tag (%s) not found in tag set (%s)", tagID, tagSetID)
+	}
+
+	if err := setTag(ctx, d, tagSetID, &updatedTagSet.Tags[i]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tag updated (%s)", d.Id())
+	return nil
+}
@@ -76,11 +76,27 @@ func resourceTagSetRead(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceTagSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tagSetMutex.Lock()
+	defer tagSetMutex.Unlock()
+
 	tagSet := expandTagSet(d)
 
 	log.Printf("[INFO] updating tag set: %#v", tagSet)
 
 	client := m.(*octopusdeploy.Client)
+
+	// the tags that are managed elsewhere are retained when they are ignored
+	if d.Get("ignore_undeclared_tags").(bool) {
+		currentTagSet, err := client.TagSets.GetByID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		previouslyDeclaredTags, declaredTags := d.GetChange("tag")
+		undeclaredTags := getUndeclaredTags(currentTagSet.Tags, declaredTags.([]interface{}), previouslyDeclaredTags.([]interface{}))
+		tagSet.Tags = append(tagSet.Tags, undeclaredTags...)
+	}
+
 	updatedTagSet, err := client.TagSets.Update(tagSet)
 	if err != nil {
 		return diag.FromErr(err)
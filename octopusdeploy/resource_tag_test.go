@@ -0,0 +1,120 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTagBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_tag." + localName
+
+	color := "#6e6e6e"
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tagSetName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	newColor := "#00ff00"
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccTagCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTagExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "canonical_tag_name", tagSetName+"/"+name),
+					resource.TestCheckResourceAttr(prefix, "color", color),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr("octopusdeploy_tag_set."+localName, "tag.#", "1"),
+				),
+				Config: testAccTagBasic(localName, tagSetName, name, description, color),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTagExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "color", newColor),
+					resource.TestCheckResourceAttr("octopusdeploy_tag_set."+localName, "tag.#", "1"),
+				),
+				Config: testAccTagBasic(localName, tagSetName, name, description, newColor),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func testAccTagBasic(localName string, tagSetName string, name string, description string, color string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_tag_set" "%s" {
+		ignore_undeclared_tags = true
+		name                   = "%s"
+
+		tag {
+			color = "#ff0000"
+			name  = "Declared"
+		}
+	}
+
+	resource "octopusdeploy_tag" "%s" {
+		color       = "%s"
+		description = "%s"
+		name        = "%s"
+		tag_set_id  = octopusdeploy_tag_set.%s.id
+	}`, localName, tagSetName, localName, color, description, name, localName)
+}
+
+func testAccTagExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		tagSetID, tagID, err := parseTagID(s.RootModule().Resources[prefix].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		tagSet, err := client.TagSets.GetByID(tagSetID)
+		if err != nil {
+			return err
+		}
+
+		if findTag(tagSet.Tags, func(t octopusdeploy.Tag) bool { return t.ID == tagID }) < 0 {
+			return fmt.Errorf("tag (%s) not found in tag set (%s)", tagID, tagSetID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTagCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_tag" {
+			continue
+		}
+
+		tagSetID, tagID, err := parseTagID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		tagSet, err := client.TagSets.GetByID(tagSetID)
+		if err != nil {
+			continue
+		}
+
+		if findTag(tagSet.Tags, func(t octopusdeploy.Tag) bool { return t.ID == tagID }) >= 0 {
+			return fmt.Errorf("tag (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -84,6 +84,24 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	team := expandTeam(d)
 	client := m.(*octopusdeploy.Client)
+
+	// the members of this team are also modified by
+	// octopusdeploy_team_membership
+	teamMutex.Lock()
+	defer teamMutex.Unlock()
+
+	// the members are only sent if they are declared and have changed;
+	// otherwise, the current members (e.g. those that are added through
+	// octopusdeploy_team_membership) are retained
+	if !d.HasChange("users") {
+		currentTeam, err := client.Teams.GetByID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		team.MemberUserIDs = currentTeam.MemberUserIDs
+	}
+
 	updatedTeam, err := client.Teams.Update(team)
 	if err != nil {
 		return diag.FromErr(err)
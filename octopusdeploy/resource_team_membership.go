@@ -0,0 +1,128 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTeamMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMembershipCreate,
+		DeleteContext: resourceTeamMembershipDelete,
+		Description:   "This resource adds a user to a team in Octopus Deploy without affecting the other members of the team. The `users` of a team that has memberships should not be specified in `octopusdeploy_team`.",
+		Importer:      getImporter(),
+		ReadContext:   resourceTeamMembershipRead,
+		Schema:        getTeamMembershipSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+	}
+}
+
+func resourceTeamMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] adding user (%s) to team (%s)", userID, teamID)
+
+	client := m.(*octopusdeploy.Client)
+	if err := updateTeamMembership(ctx, client, teamID, userID, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setTeamMembership(ctx, d, teamID, userID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] team membership created (%s)", d.Id())
+	return nil
+}
+
+func resourceTeamMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] removing user (%s) from team (%s)", userID, teamID)
+
+	client := m.(*octopusdeploy.Client)
+	if err := updateTeamMembership(ctx, client, teamID, userID, false, d.Timeout(schema.TimeoutDelete)); err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if !ok || apiError.StatusCode != 404 {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] team membership deleted")
+	return nil
+}
+
+func resourceTeamMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading team membership (%s)", d.Id())
+
+	teamID, userID, err := parseTeamMembershipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	team, err := getVersionedTeam(client, teamID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] team (%s) not found; deleting team membership from state", teamID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !isTeamMember(team.Team, userID) {
+		log.Printf("[INFO] user (%s) is not a member of team (%s); deleting from state", userID, teamID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := setTeamMembership(ctx, d, teamID, userID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] team membership read (%s)", d.Id())
+	return nil
+}
+
+// updateTeamMembership adds a user to (or removes a user from) a team without
+// affecting the other members of the team.
+func updateTeamMembership(ctx context.Context, client *octopusdeploy.Client, teamID string, userID string, isMember bool, timeout time.Duration) error {
+	teamMutex.Lock()
+	defer teamMutex.Unlock()
+
+	_, err := updateVersionedTeam(ctx, client, teamID, timeout, func(team *octopusdeploy.Team) bool {
+		if isTeamMember(team, userID) == isMember {
+			return false
+		}
+
+		memberUserIDs := []string{}
+		for _, memberUserID := range team.MemberUserIDs {
+			if memberUserID != userID {
+				memberUserIDs = append(memberUserIDs, memberUserID)
+			}
+		}
+
+		if isMember {
+			memberUserIDs = append(memberUserIDs, userID)
+		}
+		team.MemberUserIDs = memberUserIDs
+
+		return true
+	})
+
+	return err
+}
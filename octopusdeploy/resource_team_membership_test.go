@@ -0,0 +1,119 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTeamMembershipBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_team_membership." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	teamName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccTeamMembershipCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTeamMembershipExists(prefix),
+					testAccTeamMembershipExists("octopusdeploy_team_membership.other"),
+					resource.TestCheckResourceAttrPair(prefix, "team_id", "octopusdeploy_team."+localName, "id"),
+					resource.TestCheckResourceAttrPair(prefix, "user_id", "octopusdeploy_user."+localName, "id"),
+				),
+				Config: testAccTeamMembershipBasic(localName, teamName, description),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTeamMembershipExists(prefix),
+					testAccTeamMembershipExists("octopusdeploy_team_membership.other"),
+					resource.TestCheckResourceAttr("octopusdeploy_team."+localName, "description", newDescription),
+				),
+				Config: testAccTeamMembershipBasic(localName, teamName, newDescription),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func testAccTeamMembershipBasic(localName string, teamName string, description string) string {
+	return fmt.Sprintf(testAccTeamBasic(localName, teamName, description)+"\n"+
+		testAccTeamMembershipUser(localName)+"\n"+
+		testAccTeamMembershipUser("other")+"\n"+
+		`resource "octopusdeploy_team_membership" "%s" {
+			team_id = octopusdeploy_team.%s.id
+			user_id = octopusdeploy_user.%s.id
+		}
+
+		resource "octopusdeploy_team_membership" "other" {
+			team_id = octopusdeploy_team.%s.id
+			user_id = octopusdeploy_user.other.id
+		}`, localName, localName, localName, localName)
+}
+
+func testAccTeamMembershipUser(localName string) string {
+	username := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(`resource "octopusdeploy_user" "%s" {
+		display_name  = "%s"
+		email_address = "%s@example.com"
+		is_active     = true
+		is_service    = true
+		username      = "%s"
+	}`, localName, username, username, username)
+}
+
+func testAccTeamMembershipExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		teamID, userID, err := parseTeamMembershipID(s.RootModule().Resources[prefix].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		team, err := getVersionedTeam(client, teamID)
+		if err != nil {
+			return err
+		}
+
+		if !isTeamMember(team.Team, userID) {
+			return fmt.Errorf("user (%s) is not a member of team (%s)", userID, teamID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTeamMembershipCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_team_membership" {
+			continue
+		}
+
+		teamID, userID, err := parseTeamMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		team, err := getVersionedTeam(client, teamID)
+		if err == nil && isTeamMember(team.Team, userID) {
+			return fmt.Errorf("user (%s) is still a member of team (%s)", userID, teamID)
+		}
+	}
+
+	return nil
+}
@@ -80,10 +80,23 @@ func resourceTenantRead(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceTenantUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tenantProjectEnvironmentsMutex.Lock()
+	defer tenantProjectEnvironmentsMutex.Unlock()
+
 	log.Printf("[INFO] updating tenant (%s)", d.Id())
 
 	tenant := expandTenant(d)
 	client := m.(*octopusdeploy.Client)
+
+	// the current connections to projects are retained when they are ignored
+	if d.Get("ignore_project_environments").(bool) {
+		currentTenant, err := client.Tenants.GetByID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tenant.ProjectEnvironments = currentTenant.ProjectEnvironments
+	}
+
 	updatedTenant, err := client.Tenants.Update(tenant)
 	if err != nil {
 		return diag.FromErr(err)
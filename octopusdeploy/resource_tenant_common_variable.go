@@ -0,0 +1,164 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTenantCommonVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenantCommonVariableCreate,
+		CustomizeDiff: resourceTenantCommonVariableCustomizeDiff,
+		DeleteContext: resourceTenantCommonVariableDelete,
+		Description:   "This resource manages the value of a library variable set template (common variable) of a tenant in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceTenantCommonVariableRead,
+		Schema:        getTenantCommonVariableSchema(),
+		UpdateContext: resourceTenantCommonVariableUpdate,
+	}
+}
+
+func resourceTenantCommonVariableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{"library_variable_set_id", "template_id"}
+	if !isTenantVariableDiffValidatable(d, keys) {
+		return nil
+	}
+
+	client := m.(*octopusdeploy.Client)
+	return validateTenantCommonVariableTemplate(client, d.Get("library_variable_set_id").(string), d.Get("template_id").(string))
+}
+
+func resourceTenantCommonVariableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] creating tenant common variable (%s)", getTenantCommonVariableID(d))
+
+	if err := putTenantCommonVariable(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant common variable created (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantCommonVariableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tenantVariablesMutex.Lock()
+	defer tenantVariablesMutex.Unlock()
+
+	log.Printf("[INFO] deleting tenant common variable (%s)", d.Id())
+
+	tenantID, libraryVariableSetID, templateID, err := parseTenantCommonVariableID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	variables, err := getTenantVariables(client, tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if libraryVariables, ok := variables.LibraryVariables[libraryVariableSetID]; ok {
+		delete(libraryVariables.Variables, templateID)
+		if _, err := updateTenantVariables(client, variables); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] tenant common variable deleted")
+	return nil
+}
+
+func resourceTenantCommonVariableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading tenant common variable (%s)", d.Id())
+
+	tenantID, libraryVariableSetID, templateID, err := parseTenantCommonVariableID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	variables, err := getTenantVariables(client, tenantID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] tenant (%s) not found; deleting tenant common variable from state", tenantID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	libraryVariables, ok := variables.LibraryVariables[libraryVariableSetID]
+	if !ok {
+		log.Printf("[INFO] tenant common variable (%s) not found; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	value, ok := flattenTenantVariableValue(libraryVariables.Variables[templateID], d.Get("value").(string))
+	if !ok {
+		log.Printf("[INFO] tenant common variable (%s) not found; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	setTenantCommonVariable(ctx, d, tenantID, libraryVariableSetID, templateID, value)
+
+	log.Printf("[INFO] tenant common variable read (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantCommonVariableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating tenant common variable (%s)", d.Id())
+
+	if err := putTenantCommonVariable(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant common variable updated (%s)", d.Id())
+	return nil
+}
+
+func putTenantCommonVariable(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	tenantVariablesMutex.Lock()
+	defer tenantVariablesMutex.Unlock()
+
+	tenantID := d.Get("tenant_id").(string)
+	libraryVariableSetID := d.Get("library_variable_set_id").(string)
+	templateID := d.Get("template_id").(string)
+	value := d.Get("value").(string)
+
+	client := m.(*octopusdeploy.Client)
+	variables, err := getTenantVariables(client, tenantID)
+	if err != nil {
+		return err
+	}
+
+	libraryVariables, err := variables.getLibraryVariables(libraryVariableSetID)
+	if err != nil {
+		return err
+	}
+
+	template := findTenantVariableTemplate(libraryVariables.Templates, templateID)
+	if template == nil {
+		return fmt.Errorf("template (%s) does not belong to library variable set (%s)", templateID, libraryVariableSetID)
+	}
+
+	libraryVariables.Variables[templateID], err = expandTenantVariableValue(template, value)
+	if err != nil {
+		return err
+	}
+
+	if _, err := updateTenantVariables(client, variables); err != nil {
+		return err
+	}
+
+	setTenantCommonVariable(ctx, d, tenantID, libraryVariableSetID, templateID, value)
+	return nil
+}
@@ -0,0 +1,140 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTenantCommonVariableBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_tenant_common_variable." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccTenantCommonVariableCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantCommonVariableExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "value", "foo"),
+				),
+				Config: testAccTenantCommonVariableBasic(localName, "foo"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantCommonVariableExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "value", "bar"),
+				),
+				Config: testAccTenantCommonVariableBasic(localName, "bar"),
+			},
+		},
+	})
+}
+
+func testAccTenantCommonVariableBasic(localName string, value string) string {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	libraryVariableSetName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccLifecycleBasic(lifecycleLocalName, lifecycleName)+"\n"+
+		testAccProjectGroupBasic(projectGroupLocalName, projectGroupName)+"\n"+
+		testEnvironmentMinimum(localName, environmentName)+"\n"+
+		`resource "octopusdeploy_library_variable_set" "%[1]s" {
+			name = "%[2]s"
+
+			template {
+				name = "Password"
+
+				display_settings = {
+					"Octopus.ControlType" = "Sensitive"
+				}
+			}
+		}
+
+		resource "octopusdeploy_project" "%[1]s" {
+			included_library_variable_sets = [octopusdeploy_library_variable_set.%[1]s.id]
+			lifecycle_id                   = octopusdeploy_lifecycle.%[3]s.id
+			name                           = "%[4]s"
+			project_group_id               = octopusdeploy_project_group.%[5]s.id
+		}
+
+		resource "octopusdeploy_tenant" "%[1]s" {
+			name = "%[6]s"
+
+			project_environment {
+				environments = [octopusdeploy_environment.%[1]s.id]
+				project_id   = octopusdeploy_project.%[1]s.id
+			}
+		}
+
+		resource "octopusdeploy_tenant_common_variable" "%[1]s" {
+			library_variable_set_id = octopusdeploy_library_variable_set.%[1]s.id
+			template_id             = octopusdeploy_library_variable_set.%[1]s.template[0].id
+			tenant_id               = octopusdeploy_tenant.%[1]s.id
+			value                   = "%[7]s"
+		}`, localName, libraryVariableSetName, lifecycleLocalName, projectName, projectGroupLocalName, tenantName, value)
+}
+
+func testAccTenantCommonVariableExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		tenantID, libraryVariableSetID, templateID, err := parseTenantCommonVariableID(s.RootModule().Resources[prefix].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		variables, err := getTenantVariables(client, tenantID)
+		if err != nil {
+			return err
+		}
+
+		libraryVariables, ok := variables.LibraryVariables[libraryVariableSetID]
+		if !ok {
+			return fmt.Errorf("tenant (%s) is not connected to library variable set (%s)", tenantID, libraryVariableSetID)
+		}
+
+		if _, ok := libraryVariables.Variables[templateID]; !ok {
+			return fmt.Errorf("tenant common variable (%s) not found", s.RootModule().Resources[prefix].Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTenantCommonVariableCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_tenant_common_variable" {
+			continue
+		}
+
+		tenantID, libraryVariableSetID, templateID, err := parseTenantCommonVariableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		variables, err := getTenantVariables(client, tenantID)
+		if err != nil {
+			continue
+		}
+
+		if libraryVariables, ok := variables.LibraryVariables[libraryVariableSetID]; ok {
+			if _, ok := libraryVariables.Variables[templateID]; ok {
+				return fmt.Errorf("tenant common variable (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTenantProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenantProjectCreate,
+		DeleteContext: resourceTenantProjectDelete,
+		Description:   "This resource manages the connection of a tenant to a project in Octopus Deploy. Other connections of the tenant are left untouched.",
+		Importer:      getImporter(),
+		ReadContext:   resourceTenantProjectRead,
+		Schema:        getTenantProjectSchema(),
+		UpdateContext: resourceTenantProjectUpdate,
+	}
+}
+
+func resourceTenantProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tenantProjectEnvironmentsMutex.Lock()
+	defer tenantProjectEnvironmentsMutex.Unlock()
+
+	tenantID := d.Get("tenant_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	log.Printf("[INFO] connecting tenant (%s) to project (%s)", tenantID, projectID)
+
+	client := m.(*octopusdeploy.Client)
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, ok := tenant.ProjectEnvironments[projectID]; ok {
+		return diag.Errorf("tenant (%s) is already connected to project (%s); import the connection to manage it", tenantID, projectID)
+	}
+
+	if tenant.ProjectEnvironments == nil {
+		tenant.ProjectEnvironments = map[string][]string{}
+	}
+	tenant.ProjectEnvironments[projectID] = expandTenantProjectEnvironmentIDs(d)
+
+	updatedTenant, err := client.Tenants.Update(tenant)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setTenantProject(ctx, d, tenantID, projectID, updatedTenant.ProjectEnvironments[projectID]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant project created (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tenantProjectEnvironmentsMutex.Lock()
+	defer tenantProjectEnvironmentsMutex.Unlock()
+
+	log.Printf("[INFO] deleting tenant project (%s)", d.Id())
+
+	tenantID, projectID, err := parseTenantProjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if _, ok := tenant.ProjectEnvironments[projectID]; ok {
+		delete(tenant.ProjectEnvironments, projectID)
+		if _, err := client.Tenants.Update(tenant); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] tenant project deleted")
+	return nil
+}
+
+func resourceTenantProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading tenant project (%s)", d.Id())
+
+	tenantID, projectID, err := parseTenantProjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] tenant (%s) not found; deleting tenant project from state", tenantID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	environmentIDs, ok := tenant.ProjectEnvironments[projectID]
+	if !ok {
+		log.Printf("[INFO] tenant project (%s) not found; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := setTenantProject(ctx, d, tenantID, projectID, environmentIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant project read (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tenantProjectEnvironmentsMutex.Lock()
+	defer tenantProjectEnvironmentsMutex.Unlock()
+
+	log.Printf("[INFO] updating tenant project (%s)", d.Id())
+
+	tenantID, projectID, err := parseTenantProjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tenant.ProjectEnvironments == nil {
+		tenant.ProjectEnvironments = map[string][]string{}
+	}
+	tenant.ProjectEnvironments[projectID] = expandTenantProjectEnvironmentIDs(d)
+
+	updatedTenant, err := client.Tenants.Update(tenant)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setTenantProject(ctx, d, tenantID, projectID, updatedTenant.ProjectEnvironments[projectID]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant project updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,123 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTenantProjectBasic(t *testing.T) {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	otherEnvironmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_tenant_project." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccTenantProjectCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantProjectExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "environment_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(prefix, "project_id", "octopusdeploy_project."+projectLocalName, "id"),
+					resource.TestCheckResourceAttrPair(prefix, "tenant_id", "octopusdeploy_tenant."+localName, "id"),
+				),
+				Config: testAccTenantProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, projectDescription, environmentLocalName, environmentName, otherEnvironmentName, localName, tenantName, false),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantProjectExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "environment_ids.#", "2"),
+				),
+				Config: testAccTenantProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, projectDescription, environmentLocalName, environmentName, otherEnvironmentName, localName, tenantName, true),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      prefix,
+			},
+		},
+	})
+}
+
+func testAccTenantProjectBasic(lifecycleLocalName string, lifecycleName string, projectGroupLocalName string, projectGroupName string, projectLocalName string, projectName string, projectDescription string, environmentLocalName string, environmentName string, otherEnvironmentName string, localName string, tenantName string, includeOtherEnvironment bool) string {
+	environmentIDs := "octopusdeploy_environment." + environmentLocalName + ".id"
+	if includeOtherEnvironment {
+		environmentIDs += ", octopusdeploy_environment.other.id"
+	}
+
+	return fmt.Sprintf(testAccProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, projectDescription)+"\n"+
+		testEnvironmentMinimum(environmentLocalName, environmentName)+"\n"+
+		testEnvironmentMinimum("other", otherEnvironmentName)+"\n"+`
+	resource "octopusdeploy_tenant" "%s" {
+		ignore_project_environments = true
+		name                        = "%s"
+	}
+
+	resource "octopusdeploy_tenant_project" "%s" {
+		environment_ids = [%s]
+		project_id      = octopusdeploy_project.%s.id
+		tenant_id       = octopusdeploy_tenant.%s.id
+	}`, localName, tenantName, localName, environmentIDs, projectLocalName, localName)
+}
+
+func testAccTenantProjectExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		tenantID, projectID, err := parseTenantProjectID(s.RootModule().Resources[prefix].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		tenant, err := client.Tenants.GetByID(tenantID)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := tenant.ProjectEnvironments[projectID]; !ok {
+			return fmt.Errorf("tenant (%s) is not connected to project (%s)", tenantID, projectID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTenantProjectCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_tenant_project" {
+			continue
+		}
+
+		tenantID, projectID, err := parseTenantProjectID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		tenant, err := client.Tenants.GetByID(tenantID)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := tenant.ProjectEnvironments[projectID]; ok {
+			return fmt.Errorf("tenant (%s) is still connected to project (%s)", tenantID, projectID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTenantProjectVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenantProjectVariableCreate,
+		CustomizeDiff: resourceTenantProjectVariableCustomizeDiff,
+		DeleteContext: resourceTenantProjectVariableDelete,
+		Description:   "This resource manages the value of a project template of a tenant for an environment in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceTenantProjectVariableRead,
+		Schema:        getTenantProjectVariableSchema(),
+		UpdateContext: resourceTenantProjectVariableUpdate,
+	}
+}
+
+func resourceTenantProjectVariableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{"project_id", "template_id"}
+	if !isTenantVariableDiffValidatable(d, keys) {
+		return nil
+	}
+
+	client := m.(*octopusdeploy.Client)
+	return validateTenantProjectVariableTemplate(client, d.Get("project_id").(string), d.Get("template_id").(string))
+}
+
+func resourceTenantProjectVariableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] creating tenant project variable (%s)", getTenantProjectVariableID(d))
+
+	if err := putTenantProjectVariable(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant project variable created (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantProjectVariableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tenantVariablesMutex.Lock()
+	defer tenantVariablesMutex.Unlock()
+
+	log.Printf("[INFO] deleting tenant project variable (%s)", d.Id())
+
+	tenantID, projectID, environmentID, templateID, err := parseTenantProjectVariableID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	variables, err := getTenantVariables(client, tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if projectVariables, ok := variables.ProjectVariables[projectID]; ok {
+		delete(projectVariables.Variables[environmentID], templateID)
+		if _, err := updateTenantVariables(client, variables); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] tenant project variable deleted")
+	return nil
+}
+
+func resourceTenantProjectVariableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading tenant project variable (%s)", d.Id())
+
+	tenantID, projectID, environmentID, templateID, err := parseTenantProjectVariableID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	variables, err := getTenantVariables(client, tenantID)
+	if err != nil {
+		apiError, ok := err.(*octopusdeploy.APIError)
+		if ok && apiError.StatusCode == 404 {
+			log.Printf("[INFO] tenant (%s) not found; deleting tenant project variable from state", tenantID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	projectVariables, ok := variables.ProjectVariables[projectID]
+	if !ok {
+		log.Printf("[INFO] tenant project variable (%s) not found; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	value, ok := flattenTenantVariableValue(projectVariables.Variables[environmentID][templateID], d.Get("value").(string))
+	if !ok {
+		log.Printf("[INFO] tenant project variable (%s) not found; deleting from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	setTenantProjectVariable(ctx, d, tenantID, projectID, environmentID, templateID, value)
+
+	log.Printf("[INFO] tenant project variable read (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantProjectVariableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating tenant project variable (%s)", d.Id())
+
+	if err := putTenantProjectVariable(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant project variable updated (%s)", d.Id())
+	return nil
+}
+
+func putTenantProjectVariable(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	tenantVariablesMutex.Lock()
+	defer tenantVariablesMutex.Unlock()
+
+	tenantID := d.Get("tenant_id").(string)
+	projectID := d.Get("project_id").(string)
+	environmentID := d.Get("environment_id").(string)
+	templateID := d.Get("template_id").(string)
+	value := d.Get("value").(string)
+
+	client := m.(*octopusdeploy.Client)
+	if err := validateTenantProjectEnvironment(client, tenantID, projectID, environmentID); err != nil {
+		return err
+	}
+
+	variables, err := getTenantVariables(client, tenantID)
+	if err != nil {
+		return err
+	}
+
+	projectVariables, err := variables.getProjectVariables(projectID, environmentID)
+	if err != nil {
+		return err
+	}
+
+	template := findTenantVariableTemplate(projectVariables.Templates, templateID)
+	if template == nil {
+		return fmt.Errorf("template (%s) does not belong to project (%s)", templateID, projectID)
+	}
+
+	projectVariables.Variables[environmentID][templateID], err = expandTenantVariableValue(template, value)
+	if err != nil {
+		return err
+	}
+
+	if _, err := updateTenantVariables(client, variables); err != nil {
+		return err
+	}
+
+	setTenantProjectVariable(ctx, d, tenantID, projectID, environmentID, templateID, value)
+	return nil
+}
@@ -0,0 +1,186 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTenantProjectVariableBasic(t *testing.T) {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_tenant_project_variable." + localName
+
+	tenantConfig := testAccTenantBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, projectDescription, environmentLocalName, environmentName, tenantLocalName, tenantName, tenantDescription)
+	templateID := fmt.Sprintf("octopusdeploy_project.%s.template[0].id", projectLocalName)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccTenantProjectVariableCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tenantConfig,
+			},
+			{
+				Config:      tenantConfig + "\n" + testAccTenantProjectVariableBasic(localName, tenantLocalName, projectLocalName, environmentLocalName, `"00000000-0000-0000-0000-000000000000"`, "foo"),
+				ExpectError: regexp.MustCompile("does not belong to project"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantProjectVariableExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "value", "foo"),
+				),
+				Config: tenantConfig + "\n" + testAccTenantProjectVariableBasic(localName, tenantLocalName, projectLocalName, environmentLocalName, templateID, "foo"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantProjectVariableExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "value", "bar"),
+				),
+				Config: tenantConfig + "\n" + testAccTenantProjectVariableBasic(localName, tenantLocalName, projectLocalName, environmentLocalName, templateID, "bar"),
+			},
+			{
+				ResourceName:      prefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccTenantProjectVariableWithTenantProject verifies that a value can be
+// planned for a tenant that is connected to the project by the same apply.
+func TestAccTenantProjectVariableWithTenantProject(t *testing.T) {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	tenantName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_tenant_project_variable." + localName
+
+	templateID := fmt.Sprintf("octopusdeploy_project.%s.template[0].id", projectLocalName)
+	config := fmt.Sprintf(testAccProjectBasic(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, projectDescription)+"\n"+
+		testEnvironmentMinimum(environmentLocalName, environmentName)+"\n"+`
+	resource "octopusdeploy_tenant" "%s" {
+		ignore_project_environments = true
+		name                        = "%s"
+	}
+
+	resource "octopusdeploy_tenant_project" "%s" {
+		environment_ids = [octopusdeploy_environment.%s.id]
+		project_id      = octopusdeploy_project.%s.id
+		tenant_id       = octopusdeploy_tenant.%s.id
+	}
+
+	resource "octopusdeploy_tenant_project_variable" "%s" {
+		depends_on = [octopusdeploy_tenant_project.%s]
+
+		environment_id = octopusdeploy_environment.%s.id
+		project_id     = octopusdeploy_project.%s.id
+		template_id    = %s
+		tenant_id      = octopusdeploy_tenant.%s.id
+		value          = "foo"
+	}`, tenantLocalName, tenantName, tenantLocalName, environmentLocalName, projectLocalName, tenantLocalName, localName, tenantLocalName, environmentLocalName, projectLocalName, templateID, tenantLocalName)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccTenantProjectVariableCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccTenantProjectVariableExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "value", "foo"),
+				),
+				Config: config,
+			},
+		},
+	})
+}
+
+func testAccTenantProjectVariableBasic(localName string, tenantLocalName string, projectLocalName string, environmentLocalName string, templateID string, value string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_tenant_project_variable" "%s" {
+		environment_id = octopusdeploy_environment.%s.id
+		project_id     = octopusdeploy_project.%s.id
+		template_id    = %s
+		tenant_id      = octopusdeploy_tenant.%s.id
+		value          = "%s"
+	}`, localName, environmentLocalName, projectLocalName, templateID, tenantLocalName, value)
+}
+
+func testAccTenantProjectVariableExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		tenantID, projectID, environmentID, templateID, err := parseTenantProjectVariableID(s.RootModule().Resources[prefix].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		variables, err := getTenantVariables(client, tenantID)
+		if err != nil {
+			return err
+		}
+
+		projectVariables, ok := variables.ProjectVariables[projectID]
+		if !ok {
+			return fmt.Errorf("tenant (%s) is not connected to project (%s)", tenantID, projectID)
+		}
+
+		if _, ok := projectVariables.Variables[environmentID][templateID]; !ok {
+			return fmt.Errorf("tenant project variable (%s) not found", s.RootModule().Resources[prefix].Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTenantProjectVariableCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_tenant_project_variable" {
+			continue
+		}
+
+		tenantID, projectID, environmentID, templateID, err := parseTenantProjectVariableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		variables, err := getTenantVariables(client, tenantID)
+		if err != nil {
+			continue
+		}
+
+		if projectVariables, ok := variables.ProjectVariables[projectID]; ok {
+			if _, ok := projectVariables.Variables[environmentID][templateID]; ok {
+				return fmt.Errorf("tenant project variable (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
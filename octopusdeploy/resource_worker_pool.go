@@ -0,0 +1,156 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkerPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkerPoolCreate,
+		DeleteContext: resourceWorkerPoolDelete,
+		Description:   "This resource manages static and dynamic worker pools in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceWorkerPoolRead,
+		Schema:        getWorkerPoolSchema(),
+		UpdateContext: resourceWorkerPoolUpdate,
+	}
+}
+
+func resourceWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	workerPool := expandWorkerPool(d)
+	if err := validateWorkerPool(workerPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] creating worker pool: %#v", workerPool)
+
+	client := m.(*octopusdeploy.Client)
+	createdWorkerPool, err := client.WorkerPools.Add(workerPool)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdWorkerPool.GetID())
+
+	log.Printf("[INFO] worker pool created (%s)", d.Id())
+	return resourceWorkerPoolRead(ctx, d, m)
+}
+
+func resourceWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting worker pool (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+
+	// the default worker pool of a space cannot be deleted; it is first made a
+	// regular worker pool, which the server refuses if the space has no other
+	// worker pool that can become the default
+	if d.Get("is_default").(bool) {
+		if diags := clearDefaultWorkerPool(d, client); diags != nil {
+			return diags
+		}
+	}
+
+	if err := client.WorkerPools.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] worker pool deleted")
+	return nil
+}
+
+func resourceWorkerPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading worker pool (%s)", d.Id())
+
+	client := m.(*octopusdeploy.Client)
+	workerPool, err := client.WorkerPools.GetByID(d.Id())
+	if err != nil {
+		apiError := err.(*octopusdeploy.APIError)
+		if apiError.StatusCode == 404 {
+			log.Printf("[INFO] worker pool (%s) not found; deleting from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	workerPoolResource := toWorkerPoolResource(workerPool)
+	if workerPoolResource == nil {
+		return diag.Errorf("worker pool (%s) is of an unsupported type (%s)", d.Id(), workerPool.GetWorkerPoolType())
+	}
+
+	if err := setWorkerPool(ctx, d, workerPoolResource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] worker pool read (%s)", d.Id())
+	return nil
+}
+
+func resourceWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating worker pool (%s)", d.Id())
+
+	workerPool := expandWorkerPool(d)
+	if err := validateWorkerPool(workerPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*octopusdeploy.Client)
+	if _, err := client.WorkerPools.Update(workerPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] worker pool updated (%s)", d.Id())
+	return resourceWorkerPoolRead(ctx, d, m)
+}
+
+// clearDefaultWorkerPool makes the default worker pool a regular worker pool
+// so that it can be deleted. If the server keeps the worker pool as the
+// default, it is removed from the state instead and a warning is returned.
+func clearDefaultWorkerPool(d *schema.ResourceData, client *octopusdeploy.Client) diag.Diagnostics {
+	workerPool := expandWorkerPool(d)
+	workerPool.IsDefault = false
+
+	updatedWorkerPool, err := client.WorkerPools.Update(workerPool)
+	if err == nil {
+		if workerPoolResource := toWorkerPoolResource(updatedWorkerPool); workerPoolResource != nil && !workerPoolResource.IsDefault {
+			return nil
+		}
+	}
+
+	log.Printf("[INFO] worker pool (%s) is the default worker pool of its space; removing from state", d.Id())
+	d.SetId("")
+
+	warning := diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("worker pool (%s) is the default worker pool of its space and was not deleted", workerPool.GetID()),
+		Detail:   "Make another worker pool the default of the space to delete it.",
+	}
+	if err != nil {
+		warning.Detail = fmt.Sprintf("%s (%s)", warning.Detail, err)
+	}
+
+	return diag.Diagnostics{warning}
+}
+
+func validateWorkerPool(workerPool *octopusdeploy.WorkerPoolResource) error {
+	switch workerPool.WorkerPoolType {
+	case octopusdeploy.WorkerPoolTypeDynamic:
+		if isEmpty(string(workerPool.WorkerType)) {
+			return fmt.Errorf("worker_type is required for dynamic worker pools")
+		}
+	case octopusdeploy.WorkerPoolTypeStatic:
+		if !isEmpty(string(workerPool.WorkerType)) {
+			return fmt.Errorf("worker_type is only supported by dynamic worker pools")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccWorkerPoolImportBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_worker_pool." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccWorkerPoolCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testStaticWorkerPoolBasic(localName, name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStaticWorkerPoolBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_worker_pool." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccWorkerPoolCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testWorkerPoolExists(prefix),
+					resource.TestCheckResourceAttrSet(prefix, "id"),
+					resource.TestCheckResourceAttr(prefix, "can_add_workers", "true"),
+					resource.TestCheckResourceAttr(prefix, "is_default", "false"),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "worker_pool_type", "StaticWorkerPool"),
+				),
+				Config: testStaticWorkerPoolBasic(localName, name),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.octopusdeploy_worker_pools."+localName, "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_worker_pools."+localName, "worker_pools.#", "1"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_worker_pools."+localName, "worker_pools.0.name", name),
+				),
+				Config: testWorkerPoolDataSource(localName, name),
+			},
+		},
+	})
+}
+
+func TestAccDynamicWorkerPoolBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_worker_pool." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	workerType := "UbuntuDefault"
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccWorkerPoolCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testWorkerPoolExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "can_add_workers", "false"),
+					resource.TestCheckResourceAttr(prefix, "description", description),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "worker_pool_type", "DynamicWorkerPool"),
+					resource.TestCheckResourceAttr(prefix, "worker_type", workerType),
+				),
+				Config: testDynamicWorkerPoolBasic(localName, name, description, workerType),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testWorkerPoolExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "worker_type", "WindowsDefault"),
+				),
+				Config: testDynamicWorkerPoolBasic(localName, name, description, "WindowsDefault"),
+			},
+		},
+	})
+}
+
+func testStaticWorkerPoolBasic(localName string, name string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_worker_pool" "%s" {
+		name = "%s"
+	}`, localName, name)
+}
+
+func testDynamicWorkerPoolBasic(localName string, name string, description string, workerType string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_worker_pool" "%s" {
+		description      = "%s"
+		name             = "%s"
+		worker_pool_type = "DynamicWorkerPool"
+		worker_type      = "%s"
+	}`, localName, description, name, workerType)
+}
+
+func testWorkerPoolDataSource(localName string, name string) string {
+	return fmt.Sprintf(`%s
+
+	data "octopusdeploy_worker_pools" "%s" {
+		name = octopusdeploy_worker_pool.%s.name
+	}`, testStaticWorkerPoolBasic(localName, name), localName, localName)
+}
+
+func testWorkerPoolExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		workerPoolID := s.RootModule().Resources[prefix].Primary.ID
+		if _, err := client.WorkerPools.GetByID(workerPoolID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccWorkerPoolCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_worker_pool" {
+			continue
+		}
+
+		workerPool, err := client.WorkerPools.GetByID(rs.Primary.ID)
+		if err == nil && workerPool != nil {
+			return fmt.Errorf("worker pool (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
 	uuid "github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandAccountResource(d *schema.ResourceData) *octopusdeploy.AccountResource {
@@ -178,13 +179,20 @@ func getAccountResourceDataSchema() map[string]*schema.Schema {
 
 func getAccountResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"access_key":   getAccessKeySchema(false),
-		"account_type": getAccountTypeSchema(true),
+		"access_key":                getAccessKeySchema(false),
+		"account_test_subject_keys": getAccountTestSubjectKeysSchema(),
+		"account_type":              getAccountTypeSchema(true),
 		"active_directory_endpoint_base_uri": {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
-		"application_id":          getApplicationIDSchema(false),
+		"application_id": getApplicationIDSchema(false),
+		"audience": {
+			Computed:    true,
+			Description: "The audience of the token that is requested by this account.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
 		"authentication_endpoint": getAuthenticationEndpointSchema(false),
 		"azure_environment":       getAzureEnvironmentSchema(),
 		"certificate_data": {
@@ -202,9 +210,12 @@ func getAccountResourceSchema() map[string]*schema.Schema {
 			Sensitive: true,
 			Type:      schema.TypeString,
 		},
+		"deployment_subject_keys":   getDeploymentSubjectKeysSchema(),
 		"description":               getDescriptionSchema(),
 		"environments":              getEnvironmentsSchema(),
+		"health_subject_keys":       getHealthSubjectKeysSchema(),
 		"id":                        getIDSchema(),
+		"json_key":                  getJSONKeySchema(false),
 		"name":                      getNameSchema(true),
 		"password":                  getPasswordSchema(false),
 		"resource_manager_endpoint": getResourceManagerEndpointSchema(false),
@@ -218,6 +229,7 @@ func getAccountResourceSchema() map[string]*schema.Schema {
 			Sensitive: true,
 			Type:      schema.TypeString,
 		},
+		"role_arn":   getRoleArnSchema(false),
 		"secret_key": getSecretKeySchema(false),
 		"service_management_endpoint_base_uri": {
 			Optional: true,
@@ -227,6 +239,13 @@ func getAccountResourceSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
+		"session_duration": {
+			Computed:         true,
+			Description:      "The duration, in seconds, of the role session. Valid durations are between `900` (15 minutes) and `43200` (12 hours).",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(900, 43200)),
+		},
 		"space_id":                          getSpaceIDSchema(),
 		"subscription_id":                   getSubscriptionIDSchema(false),
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
@@ -285,3 +304,134 @@ func setAccountResource(ctx context.Context, d *schema.ResourceData, account *oc
 
 	return nil
 }
+
+// extendedAccountResource represents an account with the fields of the account
+// types that are not supported by the client (e.g. GoogleCloudAccount). The
+// client cannot convert these account types and drops their fields.
+type extendedAccountResource struct {
+	AccountTestSubjectKeys []string                      `json:"AccountTestSubjectKeys,omitempty"`
+	Audience               string                        `json:"Audience,omitempty"`
+	DeploymentSubjectKeys  []string                      `json:"DeploymentSubjectKeys,omitempty"`
+	HealthSubjectKeys      []string                      `json:"HealthSubjectKeys,omitempty"`
+	JSONKey                *octopusdeploy.SensitiveValue `json:"JsonKey,omitempty"`
+	RoleArn                string                        `json:"RoleArn,omitempty"`
+	SessionDuration        int                           `json:"SessionDuration,omitempty"`
+
+	octopusdeploy.AccountResource
+}
+
+func newExtendedAccountResource(name string, accountType octopusdeploy.AccountType) *extendedAccountResource {
+	return &extendedAccountResource{
+		AccountResource: *octopusdeploy.NewAccountResource(name, accountType),
+	}
+}
+
+// isExtendedAccountType indicates whether an account type must be managed
+// through an extended account resource.
+func isExtendedAccountType(accountType octopusdeploy.AccountType) bool {
+	switch accountType {
+	case accountTypeAmazonWebServicesOpenIDConnect, accountTypeAzureOpenIDConnect, accountTypeGoogleCloudAccount:
+		return true
+	}
+
+	return false
+}
+
+func expandExtendedAccountResource(d *schema.ResourceData, accountResource *octopusdeploy.AccountResource) *extendedAccountResource {
+	account := &extendedAccountResource{AccountResource: *accountResource}
+
+	if v, ok := d.GetOk("account_test_subject_keys"); ok {
+		account.AccountTestSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("audience"); ok {
+		account.Audience = v.(string)
+	}
+
+	if v, ok := d.GetOk("deployment_subject_keys"); ok {
+		account.DeploymentSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("health_subject_keys"); ok {
+		account.HealthSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("json_key"); ok {
+		account.JSONKey = octopusdeploy.NewSensitiveValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		account.RoleArn = v.(string)
+	}
+
+	if v, ok := d.GetOk("session_duration"); ok {
+		account.SessionDuration = v.(int)
+	}
+
+	return account
+}
+
+func setExtendedAccountResource(ctx context.Context, d *schema.ResourceData, account *extendedAccountResource) error {
+	if err := setAccountResource(ctx, d, &account.AccountResource); err != nil {
+		return err
+	}
+
+	if err := d.Set("account_test_subject_keys", account.AccountTestSubjectKeys); err != nil {
+		return fmt.Errorf("error setting account_test_subject_keys: %s", err)
+	}
+
+	d.Set("audience", account.Audience)
+
+	if err := d.Set("deployment_subject_keys", account.DeploymentSubjectKeys); err != nil {
+		return fmt.Errorf("error setting deployment_subject_keys: %s", err)
+	}
+
+	if err := d.Set("health_subject_keys", account.HealthSubjectKeys); err != nil {
+		return fmt.Errorf("error setting health_subject_keys: %s", err)
+	}
+
+	d.Set("role_arn", account.RoleArn)
+	d.Set("session_duration", account.SessionDuration)
+
+	return nil
+}
+
+func addExtendedAccountResource(client *octopusdeploy.Client, account *extendedAccountResource) (*extendedAccountResource, error) {
+	createdAccount := &extendedAccountResource{}
+	if err := apiAdd(client.Accounts.Sling, client.Accounts.BasePath, account, createdAccount); err != nil {
+		return nil, err
+	}
+
+	return createdAccount, nil
+}
+
+// getExtendedAccountResource returns the account that matches the input ID. An
+// error is returned if the account does not match any of the account types
+// provided as input.
+func getExtendedAccountResource(client *octopusdeploy.Client, id string, accountTypes ...octopusdeploy.AccountType) (*extendedAccountResource, error) {
+	account := &extendedAccountResource{}
+	if err := apiGet(client.Accounts.Sling, client.Accounts.BasePath+"/"+id, account); err != nil {
+		return nil, err
+	}
+
+	if len(accountTypes) == 0 {
+		return account, nil
+	}
+
+	for _, accountType := range accountTypes {
+		if account.AccountType == accountType {
+			return account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("account (%s) is of type %s; expected %v", id, account.AccountType, accountTypes)
+}
+
+func updateExtendedAccountResource(client *octopusdeploy.Client, account *extendedAccountResource) (*extendedAccountResource, error) {
+	updatedAccount := &extendedAccountResource{}
+	if err := apiUpdate(client.Accounts.Sling, client.Accounts.BasePath+"/"+account.GetID(), account, updatedAccount); err != nil {
+		return nil, err
+	}
+
+	return updatedAccount, nil
+}
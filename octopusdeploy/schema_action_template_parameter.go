@@ -37,7 +37,9 @@ func flattenActionTemplateParameters(actionTemplateParameters []octopusdeploy.Ac
 	flattenedActionTemplateParameters := make([]interface{}, 0)
 	for _, actionTemplateParameter := range actionTemplateParameters {
 		a := make(map[string]interface{})
-		a["default_value"] = actionTemplateParameter.DefaultValue.Value
+		if actionTemplateParameter.DefaultValue != nil {
+			a["default_value"] = actionTemplateParameter.DefaultValue.Value
+		}
 		a["display_settings"] = actionTemplateParameter.DisplaySettings
 		a["help_text"] = actionTemplateParameter.HelpText
 		a["id"] = actionTemplateParameter.ID
@@ -0,0 +1,123 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const accountTypeAmazonWebServicesOpenIDConnect = octopusdeploy.AccountType("AmazonWebServicesOidcAccount")
+
+func expandAmazonWebServicesOpenIDConnectAccount(d *schema.ResourceData) *extendedAccountResource {
+	name := d.Get("name").(string)
+
+	account := newExtendedAccountResource(name, accountTypeAmazonWebServicesOpenIDConnect)
+	account.ID = d.Id()
+	account.RoleArn = d.Get("role_arn").(string)
+	account.SessionDuration = d.Get("session_duration").(int)
+
+	if v, ok := d.GetOk("account_test_subject_keys"); ok {
+		account.AccountTestSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("deployment_subject_keys"); ok {
+		account.DeploymentSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		account.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("environments"); ok {
+		account.EnvironmentIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("health_subject_keys"); ok {
+		account.HealthSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		account.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
+		account.TenantedDeploymentMode = octopusdeploy.TenantedDeploymentMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("tenant_tags"); ok {
+		account.TenantTags = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenants"); ok {
+		account.TenantIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	return account
+}
+
+func getAmazonWebServicesOpenIDConnectAccountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_test_subject_keys": getAccountTestSubjectKeysSchema(),
+		"deployment_subject_keys":   getDeploymentSubjectKeysSchema(),
+		"description": {
+			Description: "A user-friendly description of this AWS OpenID Connect account.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"environments":        getEnvironmentsSchema(),
+		"health_subject_keys": getHealthSubjectKeysSchema(),
+		"id":                  getIDSchema(),
+		"name": {
+			Description:      "The name of this AWS OpenID Connect account.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 200)),
+		},
+		"role_arn":                          getRoleArnSchema(true),
+		"session_duration":                  getSessionDurationSchema(),
+		"space_id":                          getSpaceIDSchema(),
+		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
+		"tenants":                           getTenantsSchema(),
+		"tenant_tags":                       getTenantTagsSchema(),
+	}
+}
+
+func setAmazonWebServicesOpenIDConnectAccount(ctx context.Context, d *schema.ResourceData, account *extendedAccountResource) error {
+	d.Set("description", account.GetDescription())
+	d.Set("name", account.GetName())
+	d.Set("role_arn", account.RoleArn)
+	d.Set("session_duration", account.SessionDuration)
+	d.Set("space_id", account.GetSpaceID())
+	d.Set("tenanted_deployment_participation", account.GetTenantedDeploymentMode())
+
+	if err := d.Set("account_test_subject_keys", account.AccountTestSubjectKeys); err != nil {
+		return fmt.Errorf("error setting account_test_subject_keys: %s", err)
+	}
+
+	if err := d.Set("deployment_subject_keys", account.DeploymentSubjectKeys); err != nil {
+		return fmt.Errorf("error setting deployment_subject_keys: %s", err)
+	}
+
+	if err := d.Set("environments", account.GetEnvironmentIDs()); err != nil {
+		return fmt.Errorf("error setting environments: %s", err)
+	}
+
+	if err := d.Set("health_subject_keys", account.HealthSubjectKeys); err != nil {
+		return fmt.Errorf("error setting health_subject_keys: %s", err)
+	}
+
+	if err := d.Set("tenants", account.GetTenantIDs()); err != nil {
+		return fmt.Errorf("error setting tenants: %s", err)
+	}
+
+	if err := d.Set("tenant_tags", account.GetTenantTags()); err != nil {
+		return fmt.Errorf("error setting tenant_tags: %s", err)
+	}
+
+	d.SetId(account.GetID())
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// userAPIKey represents an API key of a user, including its expiry. The client
+// does not support the latter.
+type userAPIKey struct {
+	APIKey  string     `json:"ApiKey,omitempty"`
+	Created *time.Time `json:"Created,omitempty"`
+	Expires *time.Time `json:"Expires,omitempty"`
+	ID      string     `json:"Id,omitempty"`
+	Purpose string     `json:"Purpose"`
+	UserID  string     `json:"UserId"`
+}
+
+func expandAPIKey(d *schema.ResourceData) (*userAPIKey, error) {
+	apiKey := &userAPIKey{
+		Purpose: d.Get("purpose").(string),
+		UserID:  d.Get("user_id").(string),
+	}
+
+	if v, ok := d.GetOk("expires"); ok {
+		expires, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		apiKey.Expires = &expires
+	}
+
+	return apiKey, nil
+}
+
+func getAPIKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_key": {
+			Computed:    true,
+			Description: "The secret of this API key. It is only available when the API key is created.",
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"created": {
+			Computed:    true,
+			Description: "The time (in RFC3339 format) at which this API key was created.",
+			Type:        schema.TypeString,
+		},
+		"expires": {
+			Description:      "The time (in RFC3339 format) at which this API key expires. The API key does not expire if this is not specified.",
+			DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"id": getIDSchema(),
+		"purpose": {
+			Description:      "The purpose of this API key.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"user_id": {
+			Description:      "The ID of the user (or service account) to which this API key belongs.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+	}
+}
+
+func setAPIKey(ctx context.Context, d *schema.ResourceData, apiKey *userAPIKey) error {
+	d.Set("purpose", apiKey.Purpose)
+	d.Set("user_id", apiKey.UserID)
+
+	if apiKey.Created != nil {
+		d.Set("created", apiKey.Created.Format(time.RFC3339))
+	}
+
+	if apiKey.Expires != nil {
+		d.Set("expires", apiKey.Expires.Format(time.RFC3339))
+	}
+
+	// the secret is only returned when the API key is created
+	if len(apiKey.APIKey) > 0 {
+		d.Set("api_key", apiKey.APIKey)
+	}
+
+	d.SetId(apiKey.ID)
+
+	return nil
+}
+
+// getAPIKeysPath returns the path of the API keys of a user.
+func getAPIKeysPath(client *octopusdeploy.Client, userID string) string {
+	return client.APIKeys.BasePath + "/" + userID + "/apikeys"
+}
+
+func getAPIKey(client *octopusdeploy.Client, userID string, id string) (*userAPIKey, error) {
+	apiKey := &userAPIKey{}
+	if err := apiGet(client.APIKeys.Sling, getAPIKeysPath(client, userID)+"/"+id, apiKey); err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,98 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const feedTypeArtifactoryGeneric = octopusdeploy.FeedType("ArtifactoryGeneric")
+
+func expandArtifactoryGenericFeed(d *schema.ResourceData) *extendedFeedResource {
+	name := d.Get("name").(string)
+
+	feed := newExtendedFeedResource(name, feedTypeArtifactoryGeneric)
+	feed.ID = d.Id()
+	feed.FeedURI = d.Get("feed_uri").(string)
+	feed.Repository = d.Get("repository").(string)
+
+	if v, ok := d.GetOk("layout_regex"); ok {
+		feed.LayoutRegex = v.(string)
+	}
+
+	if v, ok := d.GetOk("package_acquisition_location_options"); ok {
+		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		feed.Password = octopusdeploy.NewSensitiveValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		feed.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		feed.Username = v.(string)
+	}
+
+	return feed
+}
+
+func getArtifactoryGenericFeedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"feed_uri": {
+			Description:      "The URL of the Artifactory instance (e.g. `https://example.jfrog.io`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		},
+		"id": getIDSchema(),
+		"layout_regex": {
+			Description: "A regular expression that extracts the package ID and version from the path of each artifact. The layout of the repository is used if omitted.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"name": {
+			Description:      "A short, memorable, unique name for this feed. Example: ACME Builds.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"package_acquisition_location_options": {
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"password": getPasswordSchema(false),
+		"repository": {
+			Description:      "The key of the generic repository in Artifactory.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"space_id": getSpaceIDSchema(),
+		"username": getUsernameSchema(false),
+	}
+}
+
+func setArtifactoryGenericFeed(ctx context.Context, d *schema.ResourceData, feed *extendedFeedResource) error {
+	d.Set("feed_uri", feed.FeedURI)
+	d.Set("layout_regex", feed.LayoutRegex)
+	d.Set("name", feed.Name)
+	d.Set("repository", feed.Repository)
+	d.Set("space_id", feed.SpaceID)
+	d.Set("username", feed.Username)
+
+	if err := d.Set("package_acquisition_location_options", feed.PackageAcquisitionLocationOptions); err != nil {
+		return fmt.Errorf("error setting package_acquisition_location_options: %s", err)
+	}
+
+	d.SetId(feed.GetID())
+
+	return nil
+}
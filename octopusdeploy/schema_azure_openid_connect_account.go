@@ -0,0 +1,157 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	uuid "github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const accountTypeAzureOpenIDConnect = octopusdeploy.AccountType("AzureOidc")
+
+func expandAzureOpenIDConnectAccount(d *schema.ResourceData) *extendedAccountResource {
+	name := d.Get("name").(string)
+
+	applicationID, _ := uuid.Parse(d.Get("application_id").(string))
+	tenantID, _ := uuid.Parse(d.Get("tenant_id").(string))
+	subscriptionID, _ := uuid.Parse(d.Get("subscription_id").(string))
+
+	account := newExtendedAccountResource(name, accountTypeAzureOpenIDConnect)
+	account.ID = d.Id()
+	account.ApplicationID = &applicationID
+	account.Audience = d.Get("audience").(string)
+	account.SubscriptionID = &subscriptionID
+	account.TenantID = &tenantID
+
+	if v, ok := d.GetOk("account_test_subject_keys"); ok {
+		account.AccountTestSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("authentication_endpoint"); ok {
+		account.AuthenticationEndpoint = v.(string)
+	}
+
+	if v, ok := d.GetOk("azure_environment"); ok {
+		account.AzureEnvironment = v.(string)
+	}
+
+	if v, ok := d.GetOk("deployment_subject_keys"); ok {
+		account.DeploymentSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		account.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("environments"); ok {
+		account.EnvironmentIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("health_subject_keys"); ok {
+		account.HealthSubjectKeys = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("resource_manager_endpoint"); ok {
+		account.ResourceManagerEndpoint = v.(string)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		account.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
+		account.TenantedDeploymentMode = octopusdeploy.TenantedDeploymentMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("tenant_tags"); ok {
+		account.TenantTags = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenants"); ok {
+		account.TenantIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	return account
+}
+
+func getAzureOpenIDConnectAccountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_test_subject_keys": getAccountTestSubjectKeysSchema(),
+		"application_id":            getApplicationIDSchema(true),
+		"audience": {
+			Default:     "api://AzureADTokenExchange",
+			Description: "The audience of the federated credential of the Azure application.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"authentication_endpoint":           getAuthenticationEndpointSchema(false),
+		"azure_environment":                 getAzureEnvironmentSchema(),
+		"deployment_subject_keys":           getDeploymentSubjectKeysSchema(),
+		"description":                       getDescriptionSchema(),
+		"environments":                      getEnvironmentsSchema(),
+		"health_subject_keys":               getHealthSubjectKeysSchema(),
+		"id":                                getIDSchema(),
+		"name":                              getNameSchema(true),
+		"resource_manager_endpoint":         getResourceManagerEndpointSchema(false),
+		"space_id":                          getSpaceIDSchema(),
+		"subscription_id":                   getSubscriptionIDSchema(true),
+		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
+		"tenants":                           getTenantsSchema(),
+		"tenant_id":                         getTenantIDSchema(true),
+		"tenant_tags":                       getTenantTagsSchema(),
+	}
+}
+
+func setAzureOpenIDConnectAccount(ctx context.Context, d *schema.ResourceData, account *extendedAccountResource) error {
+	if account.ApplicationID != nil {
+		d.Set("application_id", account.ApplicationID.String())
+	}
+
+	d.Set("audience", account.Audience)
+	d.Set("authentication_endpoint", account.AuthenticationEndpoint)
+	d.Set("azure_environment", account.AzureEnvironment)
+	d.Set("description", account.GetDescription())
+	d.Set("name", account.GetName())
+	d.Set("resource_manager_endpoint", account.ResourceManagerEndpoint)
+	d.Set("space_id", account.GetSpaceID())
+
+	if account.SubscriptionID != nil {
+		d.Set("subscription_id", account.SubscriptionID.String())
+	}
+
+	d.Set("tenanted_deployment_participation", account.GetTenantedDeploymentMode())
+
+	if account.TenantID != nil {
+		d.Set("tenant_id", account.TenantID.String())
+	}
+
+	if err := d.Set("account_test_subject_keys", account.AccountTestSubjectKeys); err != nil {
+		return fmt.Errorf("error setting account_test_subject_keys: %s", err)
+	}
+
+	if err := d.Set("deployment_subject_keys", account.DeploymentSubjectKeys); err != nil {
+		return fmt.Errorf("error setting deployment_subject_keys: %s", err)
+	}
+
+	if err := d.Set("environments", account.GetEnvironmentIDs()); err != nil {
+		return fmt.Errorf("error setting environments: %s", err)
+	}
+
+	if err := d.Set("health_subject_keys", account.HealthSubjectKeys); err != nil {
+		return fmt.Errorf("error setting health_subject_keys: %s", err)
+	}
+
+	if err := d.Set("tenants", account.GetTenantIDs()); err != nil {
+		return fmt.Errorf("error setting tenants: %s", err)
+	}
+
+	if err := d.Set("tenant_tags", account.TenantTags); err != nil {
+		return fmt.Errorf("error setting tenant_tags: %s", err)
+	}
+
+	d.SetId(account.GetID())
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// builtInFeedID is the ID of the built-in feed of a space.
+const builtInFeedID = "feeds-builtin"
+
+func getBuiltInFeedRetentionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"delete_unreleased_packages_after_days": {
+			Default:          0,
+			Description:      "The number of days after which packages that are not used by any release are deleted from the built-in feed. Packages are kept indefinitely if this is `0`.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"id":       getIDSchema(),
+		"space_id": getSpaceIDSchema(),
+	}
+}
+
+func setBuiltInFeedRetention(ctx context.Context, d *schema.ResourceData, feed map[string]interface{}) error {
+	days := 0
+	if v, ok := feed["DeleteUnreleasedPackagesAfterDays"].(float64); ok {
+		days = int(v)
+	}
+
+	d.Set("delete_unreleased_packages_after_days", days)
+	d.Set("space_id", feed["SpaceId"])
+
+	d.SetId(builtInFeedID)
+
+	return nil
+}
+
+// getBuiltInFeed returns the built-in feed as a map so that the properties
+// of the feed that are not managed by this provider are sent back unchanged.
+func getBuiltInFeed(client *octopusdeploy.Client) (map[string]interface{}, error) {
+	feed := map[string]interface{}{}
+	if err := apiGet(client.Feeds.Sling, client.Feeds.BasePath+"/"+builtInFeedID, &feed); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+// updateBuiltInFeedRetention updates the number of days after which unreleased
+// packages are deleted from the built-in feed (or keeps them indefinitely if
+// the number of days is 0).
+func updateBuiltInFeedRetention(client *octopusdeploy.Client, days int) (map[string]interface{}, error) {
+	feed, err := getBuiltInFeed(client)
+	if err != nil {
+		return nil, err
+	}
+
+	feed["DeleteUnreleasedPackagesAfterDays"] = nil
+	if days > 0 {
+		feed["DeleteUnreleasedPackagesAfterDays"] = days
+	}
+
+	updatedFeed := map[string]interface{}{}
+	if err := apiUpdate(client.Feeds.Sling, client.Feeds.BasePath+"/"+builtInFeedID, feed, &updatedFeed); err != nil {
+		return nil, err
+	}
+
+	return updatedFeed, nil
+}
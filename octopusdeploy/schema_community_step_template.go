@@ -0,0 +1,75 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getCommunityStepTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action_template_id": {
+			Computed:    true,
+			Description: "The ID of the step template that is installed from the community step template. Use this ID to reference the step template from a deployment action.",
+			Type:        schema.TypeString,
+		},
+		"action_type": {
+			Computed:    true,
+			Description: "The type of the action that is created from this step template.",
+			Type:        schema.TypeString,
+		},
+		"community_action_template_id": {
+			Description:      "The ID of the community step template to install (e.g. `CommunityActionTemplates-123`).",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"description": {
+			Computed:    true,
+			Description: "The description of the installed step template.",
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"name": {
+			Computed:    true,
+			Description: "The name of the installed step template.",
+			Type:        schema.TypeString,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID into which the community step template is installed. The default space of the Octopus Deploy server is used if omitted.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"version": {
+			Computed:         true,
+			Description:      "The version of the community step template to install. The installed step template is updated whenever this version differs from the installed version. Only the latest version of a community step template is available for installation; the latest version is installed if omitted.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	}
+}
+
+func setCommunityStepTemplate(ctx context.Context, d *schema.ResourceData, stepTemplate *octopusdeploy.ActionTemplate) error {
+	if len(stepTemplate.CommunityActionTemplateID) == 0 {
+		return fmt.Errorf("step template (%s) was not installed from a community step template", stepTemplate.GetID())
+	}
+
+	d.Set("action_template_id", stepTemplate.GetID())
+	d.Set("action_type", stepTemplate.ActionType)
+	d.Set("community_action_template_id", stepTemplate.CommunityActionTemplateID)
+	d.Set("description", stepTemplate.Description)
+	d.Set("name", stepTemplate.Name)
+	d.Set("space_id", stepTemplate.SpaceID)
+	d.Set("version", stepTemplate.Version)
+
+	d.SetId(stepTemplate.GetID())
+
+	return nil
+}
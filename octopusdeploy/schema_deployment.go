@@ -0,0 +1,269 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverTaskLogTailLines is the number of lines of the log of a failed server
+// task that are reported.
+const serverTaskLogTailLines = 20
+
+// deploymentPreview represents the preview of a deployment, including the
+// form of the prompted variables of its release.
+type deploymentPreview struct {
+	Form *deploymentForm `json:"Form"`
+}
+
+type deploymentForm struct {
+	Elements []*deploymentFormElement `json:"Elements"`
+}
+
+type deploymentFormElement struct {
+	Control *deploymentFormControl `json:"Control"`
+	Name    string                 `json:"Name"`
+}
+
+type deploymentFormControl struct {
+	Label    string `json:"Label"`
+	Name     string `json:"Name"`
+	Required bool   `json:"Required"`
+	Type     string `json:"Type"`
+}
+
+// serverTask represents the state of a server task (e.g. of a deployment).
+type serverTask struct {
+	Description          string `json:"Description"`
+	ErrorMessage         string `json:"ErrorMessage"`
+	FinishedSuccessfully bool   `json:"FinishedSuccessfully"`
+	ID                   string `json:"Id"`
+	IsCompleted          bool   `json:"IsCompleted"`
+	State                string `json:"State"`
+}
+
+type serverTaskDetails struct {
+	ActivityLogs []*activityElement `json:"ActivityLogs"`
+}
+
+type activityElement struct {
+	Children    []*activityElement    `json:"Children"`
+	LogElements []*activityLogElement `json:"LogElements"`
+}
+
+type activityLogElement struct {
+	Category    string `json:"Category"`
+	MessageText string `json:"MessageText"`
+}
+
+func expandDeployment(d *schema.ResourceData) *octopusdeploy.Deployment {
+	deployment := octopusdeploy.NewDeployment("", d.Get("environment_id").(string), d.Get("release_id").(string))
+
+	if v, ok := d.GetOk("comments"); ok {
+		deployment.Comments = v.(string)
+	}
+
+	if v, ok := d.GetOk("force_package_download"); ok {
+		deployment.ForcePackageDownload = v.(bool)
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		deployment.TenantID = v.(string)
+	}
+
+	return deployment
+}
+
+// expandDeploymentFormValues maps the values of prompted variables (keyed by
+// the names of the variables) onto the elements of the deployment form. An
+// error is returned if a required value is missing or if a value does not
+// match any prompted variable.
+func expandDeploymentFormValues(formValues map[string]interface{}, form *deploymentForm) (map[string]string, error) {
+	values := map[string]string{}
+	if form == nil {
+		form = &deploymentForm{}
+	}
+
+	for _, element := range form.Elements {
+		if element.Control == nil {
+			continue
+		}
+
+		value, ok := formValues[element.Control.Name]
+		if !ok {
+			if element.Control.Required {
+				return nil, fmt.Errorf("the prompted variable %s requires a value", element.Control.Name)
+			}
+			continue
+		}
+
+		values[element.Name] = value.(string)
+	}
+
+	for name := range formValues {
+		if !isDeploymentFormControl(form, name) {
+			return nil, fmt.Errorf("the release does not prompt for a variable named %s", name)
+		}
+	}
+
+	return values, nil
+}
+
+func isDeploymentFormControl(form *deploymentForm, name string) bool {
+	for _, element := range form.Elements {
+		if element.Control != nil && element.Control.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenActivityLogTail returns the last lines of the log of a server task.
+func flattenActivityLogTail(activityLogs []*activityElement, lines int) string {
+	messages := []string{}
+
+	var walk func(elements []*activityElement)
+	walk = func(elements []*activityElement) {
+		for _, element := range elements {
+			for _, logElement := range element.LogElements {
+				messages = append(messages, fmt.Sprintf("%-7s %s", logElement.Category, logElement.MessageText))
+			}
+			walk(element.Children)
+		}
+	}
+	walk(activityLogs)
+
+	if len(messages) > lines {
+		messages = messages[len(messages)-lines:]
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func getDeploymentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_id": {
+			Computed:    true,
+			Description: "The ID of the channel of the release of this deployment.",
+			Type:        schema.TypeString,
+		},
+		"comments": {
+			Description: "The comments of this deployment.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"environment_id": {
+			Description: "The ID of the environment to which the release is deployed.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"force_package_download": {
+			Description: "Indicates whether or not packages are downloaded even if they are already present on the deployment targets.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"form_values": {
+			Description: "The values of the prompted variables of the release, keyed by the names of the variables.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeMap,
+		},
+		"id": getIDSchema(),
+		"name": {
+			Computed:    true,
+			Description: "The name of this deployment.",
+			Type:        schema.TypeString,
+		},
+		"project_id": {
+			Computed:    true,
+			Description: "The ID of the project of the release of this deployment.",
+			Type:        schema.TypeString,
+		},
+		"release_id": {
+			Description: "The ID of the release to deploy.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"task_id": {
+			Computed:    true,
+			Description: "The ID of the server task of this deployment.",
+			Type:        schema.TypeString,
+		},
+		"tenant_id": {
+			Description: "The ID of the tenant to which the release is deployed.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setDeployment(ctx context.Context, d *schema.ResourceData, deployment *octopusdeploy.Deployment) error {
+	d.Set("channel_id", deployment.ChannelID)
+	d.Set("comments", deployment.Comments)
+	d.Set("force_package_download", deployment.ForcePackageDownload)
+	d.Set("name", deployment.Name)
+	d.Set("project_id", deployment.ProjectID)
+	d.Set("space_id", deployment.SpaceID)
+	d.Set("task_id", deployment.TaskID)
+	d.Set("tenant_id", deployment.TenantID)
+
+	if deployment.EnvironmentID != nil {
+		d.Set("environment_id", *deployment.EnvironmentID)
+	}
+
+	if deployment.ReleaseID != nil {
+		d.Set("release_id", *deployment.ReleaseID)
+	}
+
+	d.SetId(deployment.GetID())
+
+	return nil
+}
+
+// getDeploymentPreview returns the preview of the deployment of a release to
+// an environment (and tenant).
+func getDeploymentPreview(client *octopusdeploy.Client, deployment *octopusdeploy.Deployment) (*deploymentPreview, error) {
+	path := client.Releases.BasePath + "/" + *deployment.ReleaseID + "/deployments/preview/" + *deployment.EnvironmentID
+	if len(deployment.TenantID) > 0 {
+		path += "/" + deployment.TenantID
+	}
+
+	preview := &deploymentPreview{}
+	if err := apiGet(client.Releases.Sling, path, preview); err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+func getServerTask(client *octopusdeploy.Client, id string) (*serverTask, error) {
+	task := &serverTask{}
+	if err := apiGet(client.Tasks.Sling, client.Tasks.BasePath+"/"+id, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// getServerTaskLogTail returns the last lines of the log of a server task.
+func getServerTaskLogTail(client *octopusdeploy.Client, id string, lines int) (string, error) {
+	details := &serverTaskDetails{}
+	path := fmt.Sprintf("%s/%s/details?verbose=false&tail=%d", client.Tasks.BasePath, id, lines)
+	if err := apiGet(client.Tasks.Sling, path, details); err != nil {
+		return "", err
+	}
+
+	return flattenActivityLogTail(details.ActivityLogs, lines), nil
+}
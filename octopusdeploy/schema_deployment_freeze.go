@@ -0,0 +1,565 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// deploymentFreeze represents a period of time during which deployments are
+// prevented. The client does not support deployment freezes.
+type deploymentFreeze struct {
+	End                           time.Time                        `json:"End"`
+	ID                            string                           `json:"Id,omitempty"`
+	Name                          string                           `json:"Name"`
+	ProjectEnvironmentScope       map[string][]string              `json:"ProjectEnvironmentScope"`
+	RecurringSchedule             *deploymentFreezeSchedule        `json:"RecurringSchedule,omitempty"`
+	Start                         time.Time                        `json:"Start"`
+	TenantProjectEnvironmentScope []*tenantProjectEnvironmentScope `json:"TenantProjectEnvironmentScope"`
+}
+
+type deploymentFreezeSchedule struct {
+	DateOfMonth         string     `json:"DateOfMonth,omitempty"`
+	DayNumberOfMonth    string     `json:"DayNumberOfMonth,omitempty"`
+	DayOfWeek           string     `json:"DayOfWeek,omitempty"`
+	DaysOfWeek          []string   `json:"DaysOfWeek,omitempty"`
+	EndAfterOccurrences int        `json:"EndAfterOccurrences,omitempty"`
+	EndOnDate           *time.Time `json:"EndOnDate,omitempty"`
+	EndType             string     `json:"EndType"`
+	MonthlyScheduleType string     `json:"MonthlyScheduleType,omitempty"`
+	Type                string     `json:"Type"`
+	Unit                int        `json:"Unit"`
+}
+
+type tenantProjectEnvironmentScope struct {
+	EnvironmentID string `json:"EnvironmentId"`
+	ProjectID     string `json:"ProjectId"`
+	TenantID      string `json:"TenantId"`
+}
+
+// deploymentFreezesPageSize is the number of deployment freezes that are read
+// per request.
+const deploymentFreezesPageSize = 100
+
+// deploymentFreezes defines a collection of deployment freezes.
+type deploymentFreezes struct {
+	Count             int                 `json:"Count"`
+	DeploymentFreezes []*deploymentFreeze `json:"DeploymentFreezes"`
+}
+
+func expandDeploymentFreeze(d *schema.ResourceData) (*deploymentFreeze, error) {
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := time.Parse(time.RFC3339, d.Get("end").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if !end.After(start) {
+		return nil, fmt.Errorf("the end of a deployment freeze must be after its start")
+	}
+
+	freeze := &deploymentFreeze{
+		End:                           end,
+		ID:                            d.Id(),
+		Name:                          d.Get("name").(string),
+		ProjectEnvironmentScope:       map[string][]string{},
+		Start:                         start,
+		TenantProjectEnvironmentScope: []*tenantProjectEnvironmentScope{},
+	}
+
+	for _, v := range d.Get("project").(*schema.Set).List() {
+		project := v.(map[string]interface{})
+		projectID := project["project_id"].(string)
+		freeze.ProjectEnvironmentScope[projectID] = append(freeze.ProjectEnvironmentScope[projectID], expandArray(project["environment_ids"].(*schema.Set).List())...)
+	}
+
+	for _, v := range d.Get("tenant").(*schema.Set).List() {
+		tenant := v.(map[string]interface{})
+		for _, environmentID := range expandArray(tenant["environment_ids"].(*schema.Set).List()) {
+			freeze.TenantProjectEnvironmentScope = append(freeze.TenantProjectEnvironmentScope, &tenantProjectEnvironmentScope{
+				EnvironmentID: environmentID,
+				ProjectID:     tenant["project_id"].(string),
+				TenantID:      tenant["tenant_id"].(string),
+			})
+		}
+	}
+
+	if v, ok := d.GetOk("recurring_schedule"); ok {
+		freeze.RecurringSchedule, err = expandDeploymentFreezeSchedule(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return freeze, nil
+}
+
+func expandDeploymentFreezeSchedule(flattenedSchedule []interface{}) (*deploymentFreezeSchedule, error) {
+	if len(flattenedSchedule) == 0 || flattenedSchedule[0] == nil {
+		return nil, nil
+	}
+
+	values := flattenedSchedule[0].(map[string]interface{})
+	recurringSchedule := &deploymentFreezeSchedule{
+		DateOfMonth:         values["date_of_month"].(string),
+		DayNumberOfMonth:    values["day_number_of_month"].(string),
+		DayOfWeek:           values["day_of_week"].(string),
+		DaysOfWeek:          expandArray(values["days_of_week"].(*schema.Set).List()),
+		EndAfterOccurrences: values["end_after_occurrences"].(int),
+		EndType:             values["end_type"].(string),
+		MonthlyScheduleType: values["monthly_schedule_type"].(string),
+		Type:                values["type"].(string),
+		Unit:                values["unit"].(int),
+	}
+
+	if endOnDate := values["end_on_date"].(string); len(endOnDate) > 0 {
+		t, err := time.Parse(time.RFC3339, endOnDate)
+		if err != nil {
+			return nil, err
+		}
+		recurringSchedule.EndOnDate = &t
+	}
+
+	switch recurringSchedule.EndType {
+	case "AfterOccurrences":
+		if recurringSchedule.EndAfterOccurrences < 1 {
+			return nil, fmt.Errorf("end_after_occurrences must be specified if the end type of a recurring schedule is AfterOccurrences")
+		}
+	case "OnDate":
+		if recurringSchedule.EndOnDate == nil {
+			return nil, fmt.Errorf("end_on_date must be specified if the end type of a recurring schedule is OnDate")
+		}
+	}
+
+	if recurringSchedule.Type == "Monthly" && len(recurringSchedule.MonthlyScheduleType) == 0 {
+		return nil, fmt.Errorf("monthly_schedule_type must be specified for a monthly recurring schedule")
+	}
+
+	return recurringSchedule, nil
+}
+
+func flattenDeploymentFreeze(freeze *deploymentFreeze) map[string]interface{} {
+	if freeze == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"end":                freeze.End.Format(time.RFC3339),
+		"id":                 freeze.ID,
+		"name":               freeze.Name,
+		"project":            flattenDeploymentFreezeProjects(freeze.ProjectEnvironmentScope),
+		"recurring_schedule": flattenDeploymentFreezeSchedule(freeze.RecurringSchedule),
+		"start":              freeze.Start.Format(time.RFC3339),
+		"tenant":             flattenDeploymentFreezeTenants(freeze.TenantProjectEnvironmentScope),
+	}
+}
+
+// flattenDeploymentFreezeProjects converts the project scope of a deployment
+// freeze (a map of project IDs to environment IDs).
+func flattenDeploymentFreezeProjects(projectEnvironmentScope map[string][]string) []interface{} {
+	projectIDs := []string{}
+	for projectID := range projectEnvironmentScope {
+		projectIDs = append(projectIDs, projectID)
+	}
+	sort.Strings(projectIDs)
+
+	flattenedProjects := []interface{}{}
+	for _, projectID := range projectIDs {
+		flattenedProjects = append(flattenedProjects, map[string]interface{}{
+			"environment_ids": flattenArray(getSortedStrings(projectEnvironmentScope[projectID])),
+			"project_id":      projectID,
+		})
+	}
+
+	return flattenedProjects
+}
+
+func flattenDeploymentFreezeSchedule(recurringSchedule *deploymentFreezeSchedule) []interface{} {
+	if recurringSchedule == nil {
+		return nil
+	}
+
+	endOnDate := ""
+	if recurringSchedule.EndOnDate != nil {
+		endOnDate = recurringSchedule.EndOnDate.Format(time.RFC3339)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"date_of_month":         recurringSchedule.DateOfMonth,
+		"day_number_of_month":   recurringSchedule.DayNumberOfMonth,
+		"day_of_week":           recurringSchedule.DayOfWeek,
+		"days_of_week":          flattenArray(recurringSchedule.DaysOfWeek),
+		"end_after_occurrences": recurringSchedule.EndAfterOccurrences,
+		"end_on_date":           endOnDate,
+		"end_type":              recurringSchedule.EndType,
+		"monthly_schedule_type": recurringSchedule.MonthlyScheduleType,
+		"type":                  recurringSchedule.Type,
+		"unit":                  recurringSchedule.Unit,
+	}}
+}
+
+// flattenDeploymentFreezeTenants groups the tenant scope of a deployment
+// freeze by tenant and project.
+func flattenDeploymentFreezeTenants(tenantProjectEnvironmentScopes []*tenantProjectEnvironmentScope) []interface{} {
+	keys := []string{}
+	environmentIDs := map[string][]string{}
+	scopes := map[string]*tenantProjectEnvironmentScope{}
+	for _, scope := range tenantProjectEnvironmentScopes {
+		key := scope.TenantID + "|" + scope.ProjectID
+		if _, ok := scopes[key]; !ok {
+			keys = append(keys, key)
+			scopes[key] = scope
+		}
+		environmentIDs[key] = append(environmentIDs[key], scope.EnvironmentID)
+	}
+	sort.Strings(keys)
+
+	flattenedTenants := []interface{}{}
+	for _, key := range keys {
+		flattenedTenants = append(flattenedTenants, map[string]interface{}{
+			"environment_ids": flattenArray(getSortedStrings(environmentIDs[key])),
+			"project_id":      scopes[key].ProjectID,
+			"tenant_id":       scopes[key].TenantID,
+		})
+	}
+
+	return flattenedTenants
+}
+
+// isDeploymentFreezeActive returns true if a deployment freeze (or any of its
+// recurrences) is in effect at the specified time.
+func isDeploymentFreezeActive(freeze *deploymentFreeze, t time.Time) bool {
+	if t.Before(freeze.Start) {
+		return false
+	}
+
+	if freeze.RecurringSchedule == nil || freeze.RecurringSchedule.Unit < 1 {
+		return t.Before(freeze.End)
+	}
+
+	// recurrences start at the time of day of the start of the freeze (in its
+	// time zone) on every day that matches the schedule
+	recurringSchedule := freeze.RecurringSchedule
+	duration := freeze.End.Sub(freeze.Start)
+	start := freeze.Start
+	occurrences := 0
+
+	for day := start; !day.After(t); day = day.AddDate(0, 0, 1) {
+		if !isDeploymentFreezeScheduled(recurringSchedule, start, day) {
+			continue
+		}
+
+		occurrences++
+		switch recurringSchedule.EndType {
+		case "AfterOccurrences":
+			if occurrences > recurringSchedule.EndAfterOccurrences {
+				return false
+			}
+		case "OnDate":
+			if recurringSchedule.EndOnDate != nil && day.After(*recurringSchedule.EndOnDate) {
+				return false
+			}
+		}
+
+		if t.Before(day.Add(duration)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDeploymentFreezeScheduled returns true if a recurrence of a deployment
+// freeze starts on the specified day.
+func isDeploymentFreezeScheduled(recurringSchedule *deploymentFreezeSchedule, start time.Time, day time.Time) bool {
+	unit := recurringSchedule.Unit
+
+	switch recurringSchedule.Type {
+	case "Daily":
+		return getDaysBetween(start, day)%unit == 0
+	case "Weekly":
+		weekStart := start.AddDate(0, 0, -int(start.Weekday()))
+		if (getDaysBetween(weekStart, day)/7)%unit != 0 {
+			return false
+		}
+		if len(recurringSchedule.DaysOfWeek) == 0 {
+			return day.Weekday() == start.Weekday()
+		}
+		for _, dayOfWeek := range recurringSchedule.DaysOfWeek {
+			if day.Weekday().String() == dayOfWeek {
+				return true
+			}
+		}
+		return false
+	case "Monthly":
+		months := (day.Year()-start.Year())*12 + int(day.Month()) - int(start.Month())
+		if months%unit != 0 {
+			return false
+		}
+		if recurringSchedule.MonthlyScheduleType == "DayOfMonth" {
+			if day.Weekday().String() != recurringSchedule.DayOfWeek {
+				return false
+			}
+			if recurringSchedule.DayNumberOfMonth == "L" {
+				return day.AddDate(0, 0, 7).Month() != day.Month()
+			}
+			return strconv.Itoa((day.Day()-1)/7+1) == recurringSchedule.DayNumberOfMonth
+		}
+		return strconv.Itoa(day.Day()) == recurringSchedule.DateOfMonth
+	case "Annually":
+		return (day.Year()-start.Year())%unit == 0 && day.Month() == start.Month() && day.Day() == start.Day()
+	}
+
+	return false
+}
+
+func getDaysBetween(from time.Time, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+func getDeploymentFreezeDataSchema() map[string]*schema.Schema {
+	dataSchema := getDeploymentFreezeSchema()
+	setDataSchema(&dataSchema)
+	dataSchema["end"].DiffSuppressFunc = nil
+	dataSchema["start"].DiffSuppressFunc = nil
+
+	return map[string]*schema.Schema{
+		"active_at": {
+			Description:      "A filter to search for deployment freezes (or recurrences of deployment freezes) that are in effect at a time (in RFC3339 format).",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"deployment_freezes": {
+			Computed:    true,
+			Description: "A list of deployment freezes that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Type:        schema.TypeList,
+		},
+		"environment_ids": {
+			Description: "A filter to search by a list of environment IDs.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"id":  getDataSchemaID(),
+		"ids": getQueryIDs(),
+		"include_complete": {
+			Description: "A filter to include deployment freezes that have ended.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"partial_name": getQueryPartialName(),
+		"project_ids": {
+			Description: "A filter to search by a list of project IDs.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"skip": getQuerySkip(),
+		"take": getQueryTake(),
+		"tenant_ids": {
+			Description: "A filter to search by a list of tenant IDs.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func getDeploymentFreezeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"end": {
+			Description:      "The end of this deployment freeze (in RFC3339 format, including the time zone offset).",
+			DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"id":   getIDSchema(),
+		"name": getNameSchema(true),
+		"project": {
+			Description: "A project and the environments of the project to which this deployment freeze applies.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"environment_ids": {
+						Description: "A list of environment IDs to which this deployment freeze applies.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						MinItems:    1,
+						Required:    true,
+						Type:        schema.TypeSet,
+					},
+					"project_id": {
+						Description:      "The ID of the project to which this deployment freeze applies.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					},
+				},
+			},
+			Optional: true,
+			Type:     schema.TypeSet,
+		},
+		"recurring_schedule": {
+			Description: "The schedule on which this deployment freeze recurs.",
+			Elem:        &schema.Resource{Schema: getDeploymentFreezeScheduleSchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"start": {
+			Description:      "The start of this deployment freeze (in RFC3339 format, including the time zone offset).",
+			DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"tenant": {
+			Description: "A tenant and the project and environments of the tenant to which this deployment freeze applies.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"environment_ids": {
+						Description: "A list of environment IDs to which this deployment freeze applies.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						MinItems:    1,
+						Required:    true,
+						Type:        schema.TypeSet,
+					},
+					"project_id": {
+						Description:      "The ID of the project to which this deployment freeze applies.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					},
+					"tenant_id": {
+						Description:      "The ID of the tenant to which this deployment freeze applies.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					},
+				},
+			},
+			Optional: true,
+			Type:     schema.TypeSet,
+		},
+	}
+}
+
+func getDeploymentFreezeScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"date_of_month": {
+			Description:      "The day of the month on which a monthly schedule recurs if its type is `DateOfMonth`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^([1-9]|[12][0-9]|3[01])$`), "must be a day of the month")),
+		},
+		"day_number_of_month": {
+			Description:      "The week of the month on which a monthly schedule recurs if its type is `DayOfMonth`. Valid values are `1`, `2`, `3`, `4`, or `L` (the last week).",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"1", "2", "3", "4", "L"}, false)),
+		},
+		"day_of_week": {
+			Description:      "The day of the week on which a monthly schedule recurs if its type is `DayOfMonth`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(daysOfWeek, false)),
+		},
+		"days_of_week": {
+			Description: "A list of days of the week on which a weekly schedule recurs.",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(daysOfWeek, false)),
+			},
+			Optional: true,
+			Type:     schema.TypeSet,
+		},
+		"end_after_occurrences": {
+			Description:      "The number of recurrences after which the schedule ends if its end type is `AfterOccurrences`.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"end_on_date": {
+			Description:      "The date (in RFC3339 format) on which the schedule ends if its end type is `OnDate`.",
+			DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"end_type": {
+			Default:          "Never",
+			Description:      "Defines when the schedule ends. Valid end types are `AfterOccurrences`, `Never`, or `OnDate`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"AfterOccurrences", "Never", "OnDate"}, false)),
+		},
+		"monthly_schedule_type": {
+			Description:      "Defines how a monthly schedule recurs. Valid types are `DateOfMonth` or `DayOfMonth`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"DateOfMonth", "DayOfMonth"}, false)),
+		},
+		"type": {
+			Description:      "The type of this schedule. Valid types are `Annually`, `Daily`, `Monthly`, or `Weekly`.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"Annually", "Daily", "Monthly", "Weekly"}, false)),
+		},
+		"unit": {
+			Description:      "The number of days, weeks, months, or years between recurrences.",
+			Required:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	}
+}
+
+func setDeploymentFreeze(ctx context.Context, d *schema.ResourceData, freeze *deploymentFreeze) error {
+	d.Set("end", freeze.End.Format(time.RFC3339))
+	d.Set("name", freeze.Name)
+	d.Set("start", freeze.Start.Format(time.RFC3339))
+
+	if err := d.Set("project", flattenDeploymentFreezeProjects(freeze.ProjectEnvironmentScope)); err != nil {
+		return fmt.Errorf("error setting project: %s", err)
+	}
+
+	if err := d.Set("recurring_schedule", flattenDeploymentFreezeSchedule(freeze.RecurringSchedule)); err != nil {
+		return fmt.Errorf("error setting recurring_schedule: %s", err)
+	}
+
+	if err := d.Set("tenant", flattenDeploymentFreezeTenants(freeze.TenantProjectEnvironmentScope)); err != nil {
+		return fmt.Errorf("error setting tenant: %s", err)
+	}
+
+	d.SetId(freeze.ID)
+
+	return nil
+}
+
+func getDeploymentFreeze(client *octopusdeploy.Client, id string) (*deploymentFreeze, error) {
+	path, err := getLinkPath(client, "DeploymentFreezes")
+	if err != nil {
+		return nil, err
+	}
+
+	freeze := &deploymentFreeze{}
+	if err := apiGet(client.Root.Sling, path+"/"+id, freeze); err != nil {
+		return nil, err
+	}
+
+	return freeze, nil
+}
@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// eventRetentionConfigurationID is the ID of the configuration section of the
+// retention of events (the audit log).
+const eventRetentionConfigurationID = "eventretention"
+
+// The default number of days after which events are archived and after which
+// archived events are deleted.
+const (
+	defaultArchivedEventRetentionDays = 365
+	defaultEventRetentionDays         = 90
+)
+
+func getEventRetentionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"archived_event_retention_days": {
+			Default:          defaultArchivedEventRetentionDays,
+			Description:      "The number of days after which archived events are deleted.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"event_retention_days": {
+			Default:          defaultEventRetentionDays,
+			Description:      "The number of days after which events are archived.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"id": getIDSchema(),
+	}
+}
+
+func setEventRetention(ctx context.Context, d *schema.ResourceData, values map[string]interface{}) error {
+	if v, ok := values["ArchivedEventRetentionDays"].(float64); ok {
+		d.Set("archived_event_retention_days", int(v))
+	}
+
+	if v, ok := values["EventRetentionDays"].(float64); ok {
+		d.Set("event_retention_days", int(v))
+	}
+
+	d.SetId(eventRetentionConfigurationID)
+
+	return nil
+}
+
+// getConfigurationValues returns the values of a configuration section of the
+// Octopus server as a map so that the values that are not managed by this
+// provider are sent back unchanged.
+func getConfigurationValues(client *octopusdeploy.Client, id string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if err := apiGet(client.Configuration.Sling, client.Configuration.BasePath+"/"+id+"/values", &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func updateConfigurationValues(client *octopusdeploy.Client, id string, values map[string]interface{}) (map[string]interface{}, error) {
+	updatedValues := map[string]interface{}{}
+	if err := apiUpdate(client.Configuration.Sling, client.Configuration.BasePath+"/"+id+"/values", values, &updatedValues); err != nil {
+		return nil, err
+	}
+
+	return updatedValues, nil
+}
+
+func updateEventRetention(client *octopusdeploy.Client, eventRetentionDays int, archivedEventRetentionDays int) (map[string]interface{}, error) {
+	values, err := getConfigurationValues(client, eventRetentionConfigurationID)
+	if err != nil {
+		return nil, err
+	}
+
+	values["ArchivedEventRetentionDays"] = archivedEventRetentionDays
+	values["EventRetentionDays"] = eventRetentionDays
+
+	return updateConfigurationValues(client, eventRetentionConfigurationID, values)
+}
@@ -193,3 +193,53 @@ func setFeed(ctx context.Context, d *schema.ResourceData, feed *octopusdeploy.Fe
 
 	return nil
 }
+
+// extendedFeedResource represents a feed with the fields of the feed types that
+// are not supported by the client (e.g. S3). The client cannot convert these
+// feed types and drops their fields.
+type extendedFeedResource struct {
+	LayoutRegex           string `json:"LayoutRegex,omitempty"`
+	Repository            string `json:"Repository,omitempty"`
+	UseMachineCredentials bool   `json:"UseMachineCredentials"`
+
+	octopusdeploy.FeedResource
+}
+
+func newExtendedFeedResource(name string, feedType octopusdeploy.FeedType) *extendedFeedResource {
+	return &extendedFeedResource{
+		FeedResource: *octopusdeploy.NewFeedResource(name, feedType),
+	}
+}
+
+func addExtendedFeedResource(client *octopusdeploy.Client, feed *extendedFeedResource) (*extendedFeedResource, error) {
+	createdFeed := &extendedFeedResource{}
+	if err := apiAdd(client.Feeds.Sling, client.Feeds.BasePath, feed, createdFeed); err != nil {
+		return nil, err
+	}
+
+	return createdFeed, nil
+}
+
+// getExtendedFeedResource returns the feed that matches the input ID. An error
+// is returned if the feed does not match the feed type provided as input.
+func getExtendedFeedResource(client *octopusdeploy.Client, id string, feedType octopusdeploy.FeedType) (*extendedFeedResource, error) {
+	feed := &extendedFeedResource{}
+	if err := apiGet(client.Feeds.Sling, client.Feeds.BasePath+"/"+id, feed); err != nil {
+		return nil, err
+	}
+
+	if feed.FeedType != feedType {
+		return nil, fmt.Errorf("feed (%s) is of type %s; expected %s", id, feed.FeedType, feedType)
+	}
+
+	return feed, nil
+}
+
+func updateExtendedFeedResource(client *octopusdeploy.Client, feed *extendedFeedResource) (*extendedFeedResource, error) {
+	updatedFeed := &extendedFeedResource{}
+	if err := apiUpdate(client.Feeds.Sling, client.Feeds.BasePath+"/"+feed.GetID(), feed, updatedFeed); err != nil {
+		return nil, err
+	}
+
+	return updatedFeed, nil
+}
@@ -0,0 +1,92 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFlattenFeedResources verifies that the feeds read as-is flatten to the
+// same values as the feeds converted by the client.
+func TestFlattenFeedResources(t *testing.T) {
+	feedsJSON := `{
+		"Items": [
+			{
+				"AccessKey": "test-access_key",
+				"FeedType": "AwsElasticContainerRegistry",
+				"Id": "Feeds-1",
+				"Name": "test-aws_elastic_container_registry",
+				"Region": "us-east-1",
+				"SpaceId": "Spaces-1"
+			},
+			{
+				"DeleteUnreleasedPackagesAfterDays": 30,
+				"FeedType": "BuiltIn",
+				"Id": "feeds-builtin",
+				"Name": "Octopus Server (built-in)",
+				"SpaceId": "Spaces-1"
+			},
+			{
+				"ApiVersion": "v2",
+				"FeedType": "Docker",
+				"FeedUri": "https://index.docker.io",
+				"Id": "Feeds-2",
+				"Name": "test-docker",
+				"RegistryPath": "test-registry_path",
+				"SpaceId": "Spaces-1",
+				"Username": "test-username"
+			},
+			{
+				"DownloadAttempts": 5,
+				"DownloadRetryBackoffSeconds": 10,
+				"FeedType": "GitHub",
+				"FeedUri": "https://api.github.com",
+				"Id": "Feeds-3",
+				"Name": "test-github",
+				"SpaceId": "Spaces-1"
+			},
+			{
+				"FeedType": "Helm",
+				"FeedUri": "https://charts.helm.sh/stable",
+				"Id": "Feeds-4",
+				"Name": "test-helm",
+				"SpaceId": "Spaces-1"
+			},
+			{
+				"DownloadAttempts": 5,
+				"DownloadRetryBackoffSeconds": 10,
+				"FeedType": "Maven",
+				"FeedUri": "https://repo.maven.apache.org/maven2/",
+				"Id": "Feeds-5",
+				"Name": "test-maven",
+				"SpaceId": "Spaces-1"
+			},
+			{
+				"DownloadAttempts": 5,
+				"DownloadRetryBackoffSeconds": 10,
+				"EnhancedMode": true,
+				"FeedType": "NuGet",
+				"FeedUri": "https://api.nuget.org/v3/index.json",
+				"Id": "Feeds-6",
+				"Name": "test-nuget",
+				"SpaceId": "Spaces-1",
+				"Username": "test-username"
+			}
+		]
+	}`
+
+	feedResources := &octopusdeploy.FeedResources{}
+	require.NoError(t, json.Unmarshal([]byte(feedsJSON), feedResources))
+	require.Len(t, feedResources.Items, 7)
+
+	feeds := octopusdeploy.ToFeeds(feedResources)
+	require.Len(t, feeds.Items, len(feedResources.Items))
+
+	for i, feed := range feeds.Items {
+		feedResource, err := octopusdeploy.ToFeedResource(feed)
+		require.NoError(t, err)
+		require.Equal(t, flattenFeed(feedResource), flattenFeed(feedResources.Items[i]))
+	}
+}
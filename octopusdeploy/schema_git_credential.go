@@ -0,0 +1,127 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// gitCredential represents a git credential in Octopus Deploy. The client does
+// not support git credentials.
+type gitCredential struct {
+	Description string               `json:"Description,omitempty"`
+	Details     gitCredentialDetails `json:"Details"`
+	ID          string               `json:"Id,omitempty"`
+	Name        string               `json:"Name"`
+	SpaceID     string               `json:"SpaceId,omitempty"`
+}
+
+type gitCredentialDetails struct {
+	Password *octopusdeploy.SensitiveValue `json:"Password,omitempty"`
+	Type     string                        `json:"Type"`
+	Username string                        `json:"Username"`
+}
+
+// gitCredentials defines a collection of git credentials with built-in
+// support for paged results.
+type gitCredentials struct {
+	Items []*gitCredential `json:"Items"`
+	octopusdeploy.PagedResults
+}
+
+func expandGitCredential(d *schema.ResourceData) *gitCredential {
+	return &gitCredential{
+		Description: d.Get("description").(string),
+		Details: gitCredentialDetails{
+			Password: octopusdeploy.NewSensitiveValue(d.Get("password").(string)),
+			Type:     "UsernamePassword",
+			Username: d.Get("username").(string),
+		},
+		ID:      d.Id(),
+		Name:    d.Get("name").(string),
+		SpaceID: d.Get("space_id").(string),
+	}
+}
+
+func flattenGitCredential(gitCredential *gitCredential) map[string]interface{} {
+	if gitCredential == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"description": gitCredential.Description,
+		"id":          gitCredential.ID,
+		"name":        gitCredential.Name,
+		"space_id":    gitCredential.SpaceID,
+		"username":    gitCredential.Details.Username,
+	}
+}
+
+func getGitCredentialDataSchema() map[string]*schema.Schema {
+	dataSchema := getGitCredentialSchema()
+	setDataSchema(&dataSchema)
+	delete(dataSchema, "password")
+
+	return map[string]*schema.Schema{
+		"git_credentials": {
+			Computed:    true,
+			Description: "A list of git credentials that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"id":   getDataSchemaID(),
+		"name": getQueryName(),
+		"skip": getQuerySkip(),
+		"take": getQueryTake(),
+	}
+}
+
+func getGitCredentialSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": getDescriptionSchema(),
+		"id":          getIDSchema(),
+		"name":        getNameSchema(true),
+		"password": {
+			Description:      "The password (or personal access token) associated with this git credential.",
+			Required:         true,
+			Sensitive:        true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"space_id": getSpaceIDSchema(),
+		"username": {
+			Description:      "The username associated with this git credential.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+	}
+}
+
+func setGitCredential(ctx context.Context, d *schema.ResourceData, gitCredential *gitCredential) error {
+	d.Set("description", gitCredential.Description)
+	d.Set("name", gitCredential.Name)
+	d.Set("space_id", gitCredential.SpaceID)
+	d.Set("username", gitCredential.Details.Username)
+
+	d.SetId(gitCredential.ID)
+
+	return nil
+}
+
+func getGitCredential(client *octopusdeploy.Client, id string) (*gitCredential, error) {
+	path, err := getLinkPath(client, "GitCredentials")
+	if err != nil {
+		return nil, err
+	}
+
+	gitCredential := &gitCredential{}
+	if err := apiGet(client.Root.Sling, path+"/"+id, gitCredential); err != nil {
+		return nil, err
+	}
+
+	return gitCredential, nil
+}
@@ -0,0 +1,92 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const accountTypeGoogleCloudAccount = octopusdeploy.AccountType("GoogleCloudAccount")
+
+func expandGoogleCloudAccount(d *schema.ResourceData) *extendedAccountResource {
+	name := d.Get("name").(string)
+
+	account := newExtendedAccountResource(name, accountTypeGoogleCloudAccount)
+	account.ID = d.Id()
+	account.JSONKey = octopusdeploy.NewSensitiveValue(d.Get("json_key").(string))
+
+	if v, ok := d.GetOk("description"); ok {
+		account.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("environments"); ok {
+		account.EnvironmentIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		account.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
+		account.TenantedDeploymentMode = octopusdeploy.TenantedDeploymentMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("tenant_tags"); ok {
+		account.TenantTags = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenants"); ok {
+		account.TenantIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	return account
+}
+
+func getGoogleCloudAccountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Description: "A user-friendly description of this Google Cloud account.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"environments": getEnvironmentsSchema(),
+		"id":           getIDSchema(),
+		"json_key":     getJSONKeySchema(true),
+		"name": {
+			Description:      "The name of this Google Cloud account.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 200)),
+		},
+		"space_id":                          getSpaceIDSchema(),
+		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
+		"tenants":                           getTenantsSchema(),
+		"tenant_tags":                       getTenantTagsSchema(),
+	}
+}
+
+func setGoogleCloudAccount(ctx context.Context, d *schema.ResourceData, account *extendedAccountResource) error {
+	d.Set("description", account.GetDescription())
+	d.Set("name", account.GetName())
+	d.Set("space_id", account.GetSpaceID())
+	d.Set("tenanted_deployment_participation", account.GetTenantedDeploymentMode())
+
+	if err := d.Set("environments", account.GetEnvironmentIDs()); err != nil {
+		return fmt.Errorf("error setting environments: %s", err)
+	}
+
+	if err := d.Set("tenants", account.GetTenantIDs()); err != nil {
+		return fmt.Errorf("error setting tenants: %s", err)
+	}
+
+	if err := d.Set("tenant_tags", account.GetTenantTags()); err != nil {
+		return fmt.Errorf("error setting tenant_tags: %s", err)
+	}
+
+	d.SetId(account.GetID())
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const feedTypeGoogleContainerRegistry = octopusdeploy.FeedType("GoogleContainerRegistry")
+
+func expandGoogleContainerRegistry(d *schema.ResourceData) *extendedFeedResource {
+	name := d.Get("name").(string)
+
+	feed := newExtendedFeedResource(name, feedTypeGoogleContainerRegistry)
+	feed.ID = d.Id()
+	feed.FeedURI = d.Get("feed_uri").(string)
+
+	if v, ok := d.GetOk("api_version"); ok {
+		feed.APIVersion = v.(string)
+	}
+
+	if v, ok := d.GetOk("package_acquisition_location_options"); ok {
+		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		feed.Password = octopusdeploy.NewSensitiveValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("registry_path"); ok {
+		feed.RegistryPath = v.(string)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		feed.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		feed.Username = v.(string)
+	}
+
+	return feed
+}
+
+func getGoogleContainerRegistrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Description: "The version of the Docker registry API (e.g. `v2`).",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"feed_uri": {
+			Description:      "The URL of the registry (e.g. `https://gcr.io` or `https://us-docker.pkg.dev`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		},
+		"id": getIDSchema(),
+		"name": {
+			Description:      "A short, memorable, unique name for this feed. Example: ACME Builds.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"package_acquisition_location_options": {
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"password": getPasswordSchema(false),
+		"registry_path": {
+			Description: "The path of the images in the registry (e.g. the ID of the Google Cloud project).",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"username": getUsernameSchema(false),
+	}
+}
+
+func setGoogleContainerRegistry(ctx context.Context, d *schema.ResourceData, feed *extendedFeedResource) error {
+	d.Set("api_version", feed.APIVersion)
+	d.Set("feed_uri", feed.FeedURI)
+	d.Set("name", feed.Name)
+	d.Set("registry_path", feed.RegistryPath)
+	d.Set("space_id", feed.SpaceID)
+	d.Set("username", feed.Username)
+
+	if err := d.Set("package_acquisition_location_options", feed.PackageAcquisitionLocationOptions); err != nil {
+		return fmt.Errorf("error setting package_acquisition_location_options: %s", err)
+	}
+
+	d.SetId(feed.GetID())
+
+	return nil
+}
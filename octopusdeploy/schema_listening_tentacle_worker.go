@@ -0,0 +1,82 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandListeningTentacleWorker(d *schema.ResourceData) *octopusdeploy.Worker {
+	flattenedEndpoint := map[string]interface{}{
+		"certificate_signature_algorithm": d.Get("certificate_signature_algorithm"),
+		"id":                              "",
+		"proxy_id":                        d.Get("proxy_id"),
+		"tentacle_url":                    d.Get("tentacle_url"),
+		"thumbprint":                      d.Get("thumbprint"),
+	}
+
+	if v, ok := d.GetOk("tentacle_version_details"); ok {
+		flattenedEndpoint["tentacle_version_details"] = v
+	}
+
+	return expandWorker(d, expandListeningTentacle(flattenedEndpoint))
+}
+
+func getListeningTentacleWorkerSchema() map[string]*schema.Schema {
+	listeningTentacleWorkerSchema := getTypedWorkerSchema()
+
+	listeningTentacleWorkerSchema["certificate_signature_algorithm"] = &schema.Schema{
+		Computed: true,
+		Optional: true,
+		Type:     schema.TypeString,
+	}
+
+	listeningTentacleWorkerSchema["proxy_id"] = &schema.Schema{
+		Description: "The proxy ID that is associated with this worker.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	listeningTentacleWorkerSchema["tentacle_version_details"] = &schema.Schema{
+		Computed: true,
+		Elem:     &schema.Resource{Schema: getTentacleVersionDetailsSchema()},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+
+	listeningTentacleWorkerSchema["tentacle_url"] = &schema.Schema{
+		Description:      "The tentacle URL of this worker.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+	}
+
+	listeningTentacleWorkerSchema["thumbprint"] = &schema.Schema{
+		Description:      "The thumbprint of this worker.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+	}
+
+	return listeningTentacleWorkerSchema
+}
+
+func setListeningTentacleWorker(ctx context.Context, d *schema.ResourceData, worker *octopusdeploy.Worker) error {
+	endpointResource, err := octopusdeploy.ToEndpointResource(worker.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	d.Set("certificate_signature_algorithm", endpointResource.CertificateSignatureAlgorithm)
+	d.Set("proxy_id", endpointResource.ProxyID)
+	d.Set("tentacle_url", endpointResource.URI.String())
+
+	if err := d.Set("tentacle_version_details", flattenTentacleVersionDetails(endpointResource.TentacleVersionDetails)); err != nil {
+		return fmt.Errorf("error setting tentacle_version_details: %s", err)
+	}
+
+	return setWorker(ctx, d, worker)
+}
@@ -0,0 +1,131 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// machineProxy represents a proxy through which the Octopus server connects
+// to deployment targets and workers. The client does not support proxies.
+type machineProxy struct {
+	Host      string                        `json:"Host"`
+	ID        string                        `json:"Id,omitempty"`
+	Name      string                        `json:"Name"`
+	Password  *octopusdeploy.SensitiveValue `json:"Password,omitempty"`
+	Port      int                           `json:"Port"`
+	ProxyType string                        `json:"ProxyType"`
+	SpaceID   string                        `json:"SpaceId,omitempty"`
+	Username  string                        `json:"Username,omitempty"`
+}
+
+// machineProxies defines a collection of machine proxies with built-in
+// support for paged results.
+type machineProxies struct {
+	Items []*machineProxy `json:"Items"`
+	octopusdeploy.PagedResults
+}
+
+func expandMachineProxy(d *schema.ResourceData) *machineProxy {
+	return &machineProxy{
+		Host:      d.Get("host").(string),
+		ID:        d.Id(),
+		Name:      d.Get("name").(string),
+		Password:  octopusdeploy.NewSensitiveValue(d.Get("password").(string)),
+		Port:      d.Get("port").(int),
+		ProxyType: "HTTP",
+		SpaceID:   d.Get("space_id").(string),
+		Username:  d.Get("username").(string),
+	}
+}
+
+func flattenMachineProxy(proxy *machineProxy) map[string]interface{} {
+	if proxy == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"host":     proxy.Host,
+		"id":       proxy.ID,
+		"name":     proxy.Name,
+		"port":     proxy.Port,
+		"space_id": proxy.SpaceID,
+		"username": proxy.Username,
+	}
+}
+
+func getMachineProxyDataSchema() map[string]*schema.Schema {
+	dataSchema := getMachineProxySchema()
+	setDataSchema(&dataSchema)
+	delete(dataSchema, "password")
+
+	return map[string]*schema.Schema{
+		"id":  getDataSchemaID(),
+		"ids": getQueryIDs(),
+		"machine_proxies": {
+			Computed:    true,
+			Description: "A list of machine proxies that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"partial_name": getQueryPartialName(),
+		"skip":         getQuerySkip(),
+		"take":         getQueryTake(),
+	}
+}
+
+func getMachineProxySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"host": {
+			Description:      "The host name or IP address of this proxy.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"id":   getIDSchema(),
+		"name": getNameSchema(true),
+		"password": {
+			Description: "The password used to authenticate with this proxy.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"port": {
+			Default:          80,
+			Description:      "The port of this proxy.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+		},
+		"space_id": getSpaceIDSchema(),
+		"username": {
+			Description: "The username used to authenticate with this proxy.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setMachineProxy(ctx context.Context, d *schema.ResourceData, proxy *machineProxy) error {
+	d.Set("host", proxy.Host)
+	d.Set("name", proxy.Name)
+	d.Set("port", proxy.Port)
+	d.Set("space_id", proxy.SpaceID)
+	d.Set("username", proxy.Username)
+
+	d.SetId(proxy.ID)
+
+	return nil
+}
+
+func getMachineProxy(client *octopusdeploy.Client, id string) (*machineProxy, error) {
+	proxy := &machineProxy{}
+	if err := apiGet(client.Proxies.Sling, client.Proxies.BasePath+"/"+id, proxy); err != nil {
+		return nil, err
+	}
+
+	return proxy, nil
+}
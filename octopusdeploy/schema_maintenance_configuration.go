@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maintenanceConfigurationID is the ID of the (singleton) maintenance
+// configuration.
+const maintenanceConfigurationID = "maintenance"
+
+// maintenanceConfiguration represents the maintenance mode of the Octopus
+// server. The client does not support reading or updating it.
+type maintenanceConfiguration struct {
+	ID                  string `json:"Id,omitempty"`
+	IsInMaintenanceMode bool   `json:"IsInMaintenanceMode"`
+}
+
+func expandMaintenanceConfiguration(d *schema.ResourceData) *maintenanceConfiguration {
+	return &maintenanceConfiguration{
+		ID:                  maintenanceConfigurationID,
+		IsInMaintenanceMode: d.Get("is_in_maintenance_mode").(bool),
+	}
+}
+
+func getMaintenanceConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"is_in_maintenance_mode": {
+			Description: "Indicates whether or not the Octopus server is in maintenance mode. Only administrators can use the server while it is in maintenance mode.",
+			Required:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+func setMaintenanceConfiguration(ctx context.Context, d *schema.ResourceData, configuration *maintenanceConfiguration) error {
+	d.Set("is_in_maintenance_mode", configuration.IsInMaintenanceMode)
+
+	d.SetId(maintenanceConfigurationID)
+
+	return nil
+}
+
+func getMaintenanceConfiguration(client *octopusdeploy.Client) (*maintenanceConfiguration, error) {
+	configuration := &maintenanceConfiguration{}
+	if err := apiGet(client.MaintenanceConfiguration.Sling, client.MaintenanceConfiguration.BasePath, configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+func updateMaintenanceConfiguration(client *octopusdeploy.Client, configuration *maintenanceConfiguration) (*maintenanceConfiguration, error) {
+	updatedConfiguration := &maintenanceConfiguration{}
+	if err := apiUpdate(client.MaintenanceConfiguration.Sling, client.MaintenanceConfiguration.BasePath, configuration, updatedConfiguration); err != nil {
+		return nil, err
+	}
+
+	return updatedConfiguration, nil
+}
@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const feedTypeOCIRegistry = octopusdeploy.FeedType("OciRegistry")
+
+func expandOCIRegistryFeed(d *schema.ResourceData) *extendedFeedResource {
+	name := d.Get("name").(string)
+
+	feed := newExtendedFeedResource(name, feedTypeOCIRegistry)
+	feed.ID = d.Id()
+	feed.FeedURI = d.Get("feed_uri").(string)
+
+	if v, ok := d.GetOk("package_acquisition_location_options"); ok {
+		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		feed.Password = octopusdeploy.NewSensitiveValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		feed.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		feed.Username = v.(string)
+	}
+
+	return feed
+}
+
+func getOCIRegistryFeedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"feed_uri": {
+			Description:      "The URL of the OCI registry, including its scheme (e.g. `oci://registry-1.docker.io`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{"oci"})),
+		},
+		"id": getIDSchema(),
+		"name": {
+			Description:      "A short, memorable, unique name for this feed. Example: ACME Builds.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"package_acquisition_location_options": {
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"password": getPasswordSchema(false),
+		"space_id": getSpaceIDSchema(),
+		"username": getUsernameSchema(false),
+	}
+}
+
+func setOCIRegistryFeed(ctx context.Context, d *schema.ResourceData, feed *extendedFeedResource) error {
+	d.Set("feed_uri", feed.FeedURI)
+	d.Set("name", feed.Name)
+	d.Set("space_id", feed.SpaceID)
+	d.Set("username", feed.Username)
+
+	if err := d.Set("package_acquisition_location_options", feed.PackageAcquisitionLocationOptions); err != nil {
+		return fmt.Errorf("error setting package_acquisition_location_options: %s", err)
+	}
+
+	d.SetId(feed.GetID())
+
+	return nil
+}
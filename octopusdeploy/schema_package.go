@@ -0,0 +1,121 @@
+package octopusdeploy
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// builtInPackage represents a package of the built-in feed. The package of the
+// client cannot be read since it maps its release notes onto a timestamp.
+type builtInPackage struct {
+	FeedID        string `json:"FeedId,omitempty"`
+	FileExtension string `json:"FileExtension,omitempty"`
+	Hash          string `json:"Hash,omitempty"`
+	ID            string `json:"Id"`
+	PackageID     string `json:"PackageId"`
+	Version       string `json:"Version"`
+}
+
+func getBuiltInPackageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"adopted": {
+			Computed:    true,
+			Description: "Indicates whether or not the package already existed in the built-in feed when it was uploaded with `IgnoreIfExists`. An adopted package is not deleted when this resource is destroyed.",
+			Type:        schema.TypeBool,
+		},
+		"content_hash": {
+			Computed:    true,
+			Description: "The SHA1 hash of the contents of the package in the built-in feed. A change to the contents of the source file forces a new package to be uploaded.",
+			ForceNew:    true,
+			Type:        schema.TypeString,
+		},
+		"feed_id": {
+			Computed:    true,
+			Description: "The ID of the feed of this package.",
+			Type:        schema.TypeString,
+		},
+		"file_extension": {
+			Computed:    true,
+			Description: "The file extension of this package (e.g. `.zip`).",
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"overwrite_mode": {
+			Default:     "FailIfExists",
+			Description: "The behaviour when the package already exists in the built-in feed. Valid modes are `FailIfExists`, `IgnoreIfExists`, or `OverwriteExisting`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"FailIfExists",
+				"IgnoreIfExists",
+				"OverwriteExisting",
+			}, false)),
+		},
+		"package_id": {
+			Computed:    true,
+			Description: "The ID of this package (e.g. `Acme.Web`), as parsed from the name of the source file.",
+			Type:        schema.TypeString,
+		},
+		"source": {
+			Description:      "The path of the local file to upload. The name of the file must include the ID and version of the package (e.g. `Acme.Web.1.0.0.zip`). The file may be produced by another resource in the same run, but it must exist when the package is uploaded.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"space_id": getSpaceIDSchema(),
+		"version": {
+			Computed:    true,
+			Description: "The version of this package (e.g. `1.0.0`), as parsed from the name of the source file.",
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setBuiltInPackage(ctx context.Context, d *schema.ResourceData, builtInPackage *builtInPackage) error {
+	if len(builtInPackage.Hash) > 0 {
+		d.Set("content_hash", strings.ToLower(builtInPackage.Hash))
+	}
+
+	d.Set("feed_id", builtInPackage.FeedID)
+	d.Set("file_extension", builtInPackage.FileExtension)
+	d.Set("package_id", builtInPackage.PackageID)
+	d.Set("version", builtInPackage.Version)
+
+	d.SetId(builtInPackage.ID)
+
+	return nil
+}
+
+func getBuiltInPackage(client *octopusdeploy.Client, id string) (*builtInPackage, error) {
+	builtInPackage := &builtInPackage{}
+	if err := apiGet(client.Packages.Sling, client.Packages.BasePath+"/"+id, builtInPackage); err != nil {
+		return nil, err
+	}
+
+	return builtInPackage, nil
+}
+
+// getFileHash returns the SHA1 hash of the contents of a file.
+func getFileHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha1.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -98,6 +98,10 @@ func getPackageSchema(required bool) *schema.Schema {
 	}
 }
 
+// expandPackageReference converts a package reference from its Terraform
+// representation. The feed is referenced by its ID alone so that packages of
+// every feed type (e.g. S3 or OciRegistry) are accepted, including those that
+// the client cannot convert.
 func expandPackageReference(tfPkg map[string]interface{}) octopusdeploy.PackageReference {
 	pkg := octopusdeploy.PackageReference{
 		AcquisitionLocation: tfPkg["acquisition_location"].(string),
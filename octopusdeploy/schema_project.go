@@ -93,10 +93,6 @@ func expandProject(d *schema.ResourceData) *octopusdeploy.Project {
 		project.TenantedDeploymentMode = octopusdeploy.TenantedDeploymentMode(v.(string))
 	}
 
-	if v, ok := d.GetOk("version_control_settings"); ok {
-		project.VersionControlSettings = expandVersionControlSettings(v)
-	}
-
 	if v, ok := d.GetOk("versioning_strategy"); ok {
 		project.VersioningStrategy = expandVersioningStrategy(v)
 	}
@@ -135,7 +131,7 @@ func flattenProject(project *octopusdeploy.Project) map[string]interface{} {
 		"template":                             flattenActionTemplateParameters(project.Templates),
 		"tenanted_deployment_participation":    project.TenantedDeploymentMode,
 		"variable_set_id":                      project.VariableSetID,
-		"version_control_settings":             flattenVersionControlSettings(project.VersionControlSettings),
+		"version_control_settings":             flattenProjectVersionControlSettings(project.VersionControlSettings),
 		"versioning_strategy":                  flattenVersioningStrategy(project.VersioningStrategy),
 	}
 }
@@ -346,15 +342,54 @@ func setProject(ctx context.Context, d *schema.ResourceData, project *octopusdep
 	d.Set("tenanted_deployment_participation", project.TenantedDeploymentMode)
 	d.Set("variable_set_id", project.VariableSetID)
 
+	if err := d.Set("versioning_strategy", flattenVersioningStrategy(project.VersioningStrategy)); err != nil {
+		return fmt.Errorf("error setting versioning_strategy: %s", err)
+	}
+
+	return nil
+}
+
+// versionControlledProject represents a project along with version control
+// settings that may reference git credentials, which are not supported by the
+// client.
+type versionControlledProject struct {
+	VersionControlSettings *versionControlSettings `json:"VersionControlSettings,omitempty"`
+
+	*octopusdeploy.Project
+}
+
+func expandVersionControlledProject(d *schema.ResourceData) (*versionControlledProject, error) {
+	project := &versionControlledProject{Project: expandProject(d)}
+
+	if v, ok := d.GetOk("version_control_settings"); ok {
+		versionControlSettings, err := expandVersionControlSettings(v)
+		if err != nil {
+			return nil, err
+		}
+		project.VersionControlSettings = versionControlSettings
+	}
+
+	return project, nil
+}
+
+func flattenProjectVersionControlSettings(projectVersionControlSettings *octopusdeploy.VersionControlSettings) []interface{} {
+	if projectVersionControlSettings == nil {
+		return nil
+	}
+
+	return flattenVersionControlSettings(&versionControlSettings{VersionControlSettings: *projectVersionControlSettings})
+}
+
+func setVersionControlledProject(ctx context.Context, d *schema.ResourceData, project *versionControlledProject) error {
+	if err := setProject(ctx, d, project.Project); err != nil {
+		return err
+	}
+
 	if project.IsVersionControlled {
 		if err := d.Set("version_control_settings", flattenVersionControlSettings(project.VersionControlSettings)); err != nil {
 			return fmt.Errorf("error setting version_control_settings: %s", err)
 		}
 	}
 
-	if err := d.Set("versioning_strategy", flattenVersioningStrategy(project.VersioningStrategy)); err != nil {
-		return fmt.Errorf("error setting versioning_strategy: %s", err)
-	}
-
 	return nil
 }
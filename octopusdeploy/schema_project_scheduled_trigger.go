@@ -0,0 +1,606 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// scheduledProjectTrigger represents a project trigger that runs on a
+// schedule. The project trigger model of the client only supports deployment
+// target triggers, so scheduled triggers are modeled here.
+type scheduledProjectTrigger struct {
+	Action      *scheduledTriggerAction `json:"Action"`
+	Description string                  `json:"Description,omitempty"`
+	Filter      *scheduledTriggerFilter `json:"Filter"`
+	ID          string                  `json:"Id,omitempty"`
+	IsDisabled  bool                    `json:"IsDisabled"`
+	Name        string                  `json:"Name"`
+	ProjectID   string                  `json:"ProjectId"`
+	SpaceID     string                  `json:"SpaceId,omitempty"`
+}
+
+type scheduledTriggerAction struct {
+	ActionType                         string   `json:"ActionType"`
+	DestinationEnvironmentID           string   `json:"DestinationEnvironmentId,omitempty"`
+	EnvironmentID                      string   `json:"EnvironmentId,omitempty"`
+	EnvironmentIDs                     []string `json:"EnvironmentIds,omitempty"`
+	RunbookID                          string   `json:"RunbookId,omitempty"`
+	ShouldRedeployWhenReleaseIsCurrent bool     `json:"ShouldRedeployWhenReleaseIsCurrent,omitempty"`
+	SourceEnvironmentIDs               []string `json:"SourceEnvironmentIds,omitempty"`
+	TenantIDs                          []string `json:"TenantIds,omitempty"`
+	TenantTags                         []string `json:"TenantTags,omitempty"`
+	Variables                          string   `json:"Variables,omitempty"`
+}
+
+type scheduledTriggerFilter struct {
+	CronExpression      string   `json:"CronExpression,omitempty"`
+	DateOfMonth         string   `json:"DateOfMonth,omitempty"`
+	DayNumberOfMonth    string   `json:"DayNumberOfMonth,omitempty"`
+	DayOfWeek           string   `json:"DayOfWeek,omitempty"`
+	DaysOfWeek          []string `json:"DaysOfWeek,omitempty"`
+	FilterType          string   `json:"FilterType"`
+	HourInterval        int      `json:"HourInterval,omitempty"`
+	Interval            string   `json:"Interval,omitempty"`
+	MinuteInterval      int      `json:"MinuteInterval,omitempty"`
+	MonthlyScheduleType string   `json:"MonthlyScheduleType,omitempty"`
+	RunAfter            string   `json:"RunAfter,omitempty"`
+	RunUntil            string   `json:"RunUntil,omitempty"`
+	StartTime           string   `json:"StartTime,omitempty"`
+	Timezone            string   `json:"Timezone,omitempty"`
+}
+
+var scheduledTriggerActions = []string{
+	"deploy_latest_release_action",
+	"deploy_new_release_action",
+	"run_runbook_action",
+}
+
+var scheduledTriggerSchedules = []string{
+	"continuous_daily_schedule",
+	"cron_expression_schedule",
+	"days_per_month_schedule",
+	"once_daily_schedule",
+}
+
+var dateOfMonthRegexp = regexp.MustCompile(`^([1-9]|[12][0-9]|3[01]|L)$`)
+
+var daysOfWeek = []string{
+	"Monday",
+	"Tuesday",
+	"Wednesday",
+	"Thursday",
+	"Friday",
+	"Saturday",
+	"Sunday",
+}
+
+func expandProjectScheduledTrigger(d *schema.ResourceData) *scheduledProjectTrigger {
+	projectScheduledTrigger := &scheduledProjectTrigger{
+		Action:      expandScheduledTriggerAction(d),
+		Description: d.Get("description").(string),
+		Filter:      expandScheduledTriggerFilter(d),
+		ID:          d.Id(),
+		IsDisabled:  d.Get("is_disabled").(bool),
+		Name:        d.Get("name").(string),
+		ProjectID:   d.Get("project_id").(string),
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		projectScheduledTrigger.SpaceID = v.(string)
+	}
+
+	return projectScheduledTrigger
+}
+
+func expandScheduledTriggerAction(d *schema.ResourceData) *scheduledTriggerAction {
+	if v, ok := d.GetOk("deploy_latest_release_action"); ok {
+		flattenedAction := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerAction{
+			ActionType:                         "DeployLatestRelease",
+			DestinationEnvironmentID:           flattenedAction["destination_environment_id"].(string),
+			ShouldRedeployWhenReleaseIsCurrent: flattenedAction["should_redeploy"].(bool),
+			SourceEnvironmentIDs:               getSliceFromTerraformTypeList(flattenedAction["source_environment_ids"]),
+			TenantIDs:                          getSliceFromTerraformTypeList(flattenedAction["tenant_ids"]),
+			TenantTags:                         getSliceFromTerraformTypeList(flattenedAction["tenant_tags"]),
+			Variables:                          flattenedAction["variables"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("deploy_new_release_action"); ok {
+		flattenedAction := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerAction{
+			ActionType:    "DeployNewRelease",
+			EnvironmentID: flattenedAction["destination_environment_id"].(string),
+			TenantIDs:     getSliceFromTerraformTypeList(flattenedAction["tenant_ids"]),
+			TenantTags:    getSliceFromTerraformTypeList(flattenedAction["tenant_tags"]),
+			Variables:     flattenedAction["variables"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("run_runbook_action"); ok {
+		flattenedAction := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerAction{
+			ActionType:     "RunRunbook",
+			EnvironmentIDs: getSliceFromTerraformTypeList(flattenedAction["target_environment_ids"]),
+			RunbookID:      flattenedAction["runbook_id"].(string),
+			TenantIDs:      getSliceFromTerraformTypeList(flattenedAction["tenant_ids"]),
+			TenantTags:     getSliceFromTerraformTypeList(flattenedAction["tenant_tags"]),
+		}
+	}
+
+	return nil
+}
+
+func expandScheduledTriggerFilter(d *schema.ResourceData) *scheduledTriggerFilter {
+	if v, ok := d.GetOk("continuous_daily_schedule"); ok {
+		flattenedSchedule := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerFilter{
+			DaysOfWeek:     getSliceFromTerraformTypeList(flattenedSchedule["days_of_week"]),
+			FilterType:     "ContinuousDailySchedule",
+			HourInterval:   flattenedSchedule["hour_interval"].(int),
+			Interval:       flattenedSchedule["interval"].(string),
+			MinuteInterval: flattenedSchedule["minute_interval"].(int),
+			RunAfter:       flattenedSchedule["run_after"].(string),
+			RunUntil:       flattenedSchedule["run_until"].(string),
+			Timezone:       flattenedSchedule["timezone"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("cron_expression_schedule"); ok {
+		flattenedSchedule := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerFilter{
+			CronExpression: flattenedSchedule["cron_expression"].(string),
+			FilterType:     "CronExpressionSchedule",
+			Timezone:       flattenedSchedule["timezone"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("days_per_month_schedule"); ok {
+		flattenedSchedule := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerFilter{
+			DateOfMonth:         flattenedSchedule["date_of_month"].(string),
+			DayNumberOfMonth:    flattenedSchedule["day_number_of_month"].(string),
+			DayOfWeek:           flattenedSchedule["day_of_week"].(string),
+			FilterType:          "DaysPerMonthSchedule",
+			MonthlyScheduleType: flattenedSchedule["monthly_schedule_type"].(string),
+			StartTime:           flattenedSchedule["start_time"].(string),
+			Timezone:            flattenedSchedule["timezone"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("once_daily_schedule"); ok {
+		flattenedSchedule := v.([]interface{})[0].(map[string]interface{})
+		return &scheduledTriggerFilter{
+			DaysOfWeek: getSliceFromTerraformTypeList(flattenedSchedule["days_of_week"]),
+			FilterType: "OnceDailySchedule",
+			StartTime:  flattenedSchedule["start_time"].(string),
+			Timezone:   flattenedSchedule["timezone"].(string),
+		}
+	}
+
+	return nil
+}
+
+// validateDaysPerMonthSchedule checks that the fields required by the monthly
+// schedule type of a days per month schedule are specified.
+func validateDaysPerMonthSchedule(flattenedSchedule map[string]interface{}) error {
+	switch flattenedSchedule["monthly_schedule_type"].(string) {
+	case "DateOfMonth":
+		if len(flattenedSchedule["date_of_month"].(string)) == 0 {
+			return fmt.Errorf("date_of_month must be specified if the monthly schedule type of a days per month schedule is DateOfMonth")
+		}
+	case "DayOfMonth":
+		if len(flattenedSchedule["day_number_of_month"].(string)) == 0 || len(flattenedSchedule["day_of_week"].(string)) == 0 {
+			return fmt.Errorf("day_number_of_month and day_of_week must be specified if the monthly schedule type of a days per month schedule is DayOfMonth")
+		}
+	}
+
+	return nil
+}
+
+func flattenScheduledTriggerAction(action *scheduledTriggerAction) (string, []interface{}) {
+	if action == nil {
+		return "", nil
+	}
+
+	switch action.ActionType {
+	case "DeployLatestRelease":
+		return "deploy_latest_release_action", []interface{}{map[string]interface{}{
+			"destination_environment_id": action.DestinationEnvironmentID,
+			"should_redeploy":            action.ShouldRedeployWhenReleaseIsCurrent,
+			"source_environment_ids":     action.SourceEnvironmentIDs,
+			"tenant_ids":                 action.TenantIDs,
+			"tenant_tags":                action.TenantTags,
+			"variables":                  action.Variables,
+		}}
+	case "DeployNewRelease":
+		return "deploy_new_release_action", []interface{}{map[string]interface{}{
+			"destination_environment_id": action.EnvironmentID,
+			"tenant_ids":                 action.TenantIDs,
+			"tenant_tags":                action.TenantTags,
+			"variables":                  action.Variables,
+		}}
+	case "RunRunbook":
+		return "run_runbook_action", []interface{}{map[string]interface{}{
+			"runbook_id":             action.RunbookID,
+			"target_environment_ids": action.EnvironmentIDs,
+			"tenant_ids":             action.TenantIDs,
+			"tenant_tags":            action.TenantTags,
+		}}
+	}
+
+	return "", nil
+}
+
+func flattenScheduledTriggerFilter(filter *scheduledTriggerFilter) (string, []interface{}) {
+	if filter == nil {
+		return "", nil
+	}
+
+	switch filter.FilterType {
+	case "ContinuousDailySchedule":
+		return "continuous_daily_schedule", []interface{}{map[string]interface{}{
+			"days_of_week":    filter.DaysOfWeek,
+			"hour_interval":   filter.HourInterval,
+			"interval":        filter.Interval,
+			"minute_interval": filter.MinuteInterval,
+			"run_after":       filter.RunAfter,
+			"run_until":       filter.RunUntil,
+			"timezone":        filter.Timezone,
+		}}
+	case "CronExpressionSchedule":
+		return "cron_expression_schedule", []interface{}{map[string]interface{}{
+			"cron_expression": filter.CronExpression,
+			"timezone":        filter.Timezone,
+		}}
+	case "DaysPerMonthSchedule":
+		return "days_per_month_schedule", []interface{}{map[string]interface{}{
+			"date_of_month":         filter.DateOfMonth,
+			"day_number_of_month":   filter.DayNumberOfMonth,
+			"day_of_week":           filter.DayOfWeek,
+			"monthly_schedule_type": filter.MonthlyScheduleType,
+			"start_time":            filter.StartTime,
+			"timezone":              filter.Timezone,
+		}}
+	case "OnceDailySchedule":
+		return "once_daily_schedule", []interface{}{map[string]interface{}{
+			"days_of_week": filter.DaysOfWeek,
+			"start_time":   filter.StartTime,
+			"timezone":     filter.Timezone,
+		}}
+	}
+
+	return "", nil
+}
+
+func getProjectScheduledTriggerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"continuous_daily_schedule": {
+			Description:  "Runs the trigger at a fixed interval on the selected days.",
+			Elem:         &schema.Resource{Schema: getContinuousDailyScheduleSchema()},
+			ExactlyOneOf: scheduledTriggerSchedules,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"cron_expression_schedule": {
+			Description:  "Runs the trigger according to a cron expression.",
+			Elem:         &schema.Resource{Schema: getCronExpressionScheduleSchema()},
+			ExactlyOneOf: scheduledTriggerSchedules,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"days_per_month_schedule": {
+			Description:  "Runs the trigger once on a specific day of each month.",
+			Elem:         &schema.Resource{Schema: getDaysPerMonthScheduleSchema()},
+			ExactlyOneOf: scheduledTriggerSchedules,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"deploy_latest_release_action": {
+			Description:  "Promotes the latest release of the source environment(s) to the destination environment.",
+			Elem:         &schema.Resource{Schema: getDeployLatestReleaseActionSchema()},
+			ExactlyOneOf: scheduledTriggerActions,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"deploy_new_release_action": {
+			Description:  "Creates a new release and deploys it to the destination environment.",
+			Elem:         &schema.Resource{Schema: getDeployNewReleaseActionSchema()},
+			ExactlyOneOf: scheduledTriggerActions,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"description": getDescriptionSchema(),
+		"id":          getIDSchema(),
+		"is_disabled": {
+			Default:     false,
+			Description: "Indicates whether or not this trigger is disabled.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": getNameSchema(true),
+		"once_daily_schedule": {
+			Description:  "Runs the trigger once at the start time on the selected days.",
+			Elem:         &schema.Resource{Schema: getOnceDailyScheduleSchema()},
+			ExactlyOneOf: scheduledTriggerSchedules,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"project_id": {
+			Description: "The project ID associated with this trigger.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"run_runbook_action": {
+			Description:  "Runs a runbook in the target environment(s).",
+			Elem:         &schema.Resource{Schema: getRunRunbookActionSchema()},
+			ExactlyOneOf: scheduledTriggerActions,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"space_id": getSpaceIDSchema(),
+	}
+}
+
+func getContinuousDailyScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"days_of_week": getDaysOfWeekSchema(),
+		"hour_interval": {
+			Description:      "The number of hours between runs. Only used when `interval` is `OnceHourly`.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 24)),
+		},
+		"interval": {
+			Description: "The interval between runs. Valid intervals are `OnceEveryMinute` or `OnceHourly`.",
+			Required:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"OnceEveryMinute",
+				"OnceHourly",
+			}, false)),
+		},
+		"minute_interval": {
+			Description:      "The number of minutes between runs. Only used when `interval` is `OnceEveryMinute`.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 60)),
+		},
+		"run_after": getScheduleTimeSchema("The time (in RFC3339 format) of day after which the trigger starts to run."),
+		"run_until": getScheduleTimeSchema("The time (in RFC3339 format) of day after which the trigger stops running."),
+		"timezone":  getScheduleTimeZoneSchema(),
+	}
+}
+
+func getCronExpressionScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cron_expression": {
+			Description:      "The cron expression of this schedule. The expression consists of six fields: seconds, minutes, hours, day-of-month, month, and day-of-week (e.g. `0 0 06 * * Mon-Fri`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateCronExpression,
+		},
+		"timezone": getScheduleTimeZoneSchema(),
+	}
+}
+
+func getDaysPerMonthScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"date_of_month": {
+			Description:      "The date of the month on which the trigger runs. Required (and only used) when `monthly_schedule_type` is `DateOfMonth`. Use `L` for the last day of the month.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(dateOfMonthRegexp, "expected a date between 1 and 31 or L")),
+		},
+		"day_number_of_month": {
+			Description: "The occurrence of `day_of_week` in the month on which the trigger runs. Required (and only used) when `monthly_schedule_type` is `DayOfMonth`. Valid values are `1`, `2`, `3`, `4`, or `L`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"1",
+				"2",
+				"3",
+				"4",
+				"L",
+			}, false)),
+		},
+		"day_of_week": {
+			Description:      "The day of the week on which the trigger runs. Required (and only used) when `monthly_schedule_type` is `DayOfMonth`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(daysOfWeek, false)),
+		},
+		"monthly_schedule_type": {
+			Description: "The type of monthly schedule. Valid types are `DateOfMonth` or `DayOfMonth`.",
+			Required:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"DateOfMonth",
+				"DayOfMonth",
+			}, false)),
+		},
+		"start_time": getScheduleTimeSchema("The time (in RFC3339 format) of day at which the trigger runs."),
+		"timezone":   getScheduleTimeZoneSchema(),
+	}
+}
+
+func getOnceDailyScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"days_of_week": getDaysOfWeekSchema(),
+		"start_time":   getScheduleTimeSchema("The time (in RFC3339 format) of day at which the trigger runs."),
+		"timezone":     getScheduleTimeZoneSchema(),
+	}
+}
+
+func getDeployLatestReleaseActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"destination_environment_id": {
+			Description: "The ID of the environment to deploy the release to.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"should_redeploy": {
+			Default:     false,
+			Description: "Indicates whether or not the release is redeployed if it is already the current release of the destination environment.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"source_environment_ids": {
+			Description: "A list of environment IDs from which the latest release is selected.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    1,
+			Required:    true,
+			Type:        schema.TypeList,
+		},
+		"tenant_ids":  getScheduledTriggerTenantIDsSchema(),
+		"tenant_tags": getScheduledTriggerTenantTagsSchema(),
+		"variables":   getScheduledTriggerVariablesSchema(),
+	}
+}
+
+func getDeployNewReleaseActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"destination_environment_id": {
+			Description: "The ID of the environment to deploy the new release to.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"tenant_ids":  getScheduledTriggerTenantIDsSchema(),
+		"tenant_tags": getScheduledTriggerTenantTagsSchema(),
+		"variables":   getScheduledTriggerVariablesSchema(),
+	}
+}
+
+func getRunRunbookActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"runbook_id": {
+			Description: "The ID of the runbook to run.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"target_environment_ids": {
+			Description: "A list of environment IDs in which the runbook is run.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    1,
+			Required:    true,
+			Type:        schema.TypeList,
+		},
+		"tenant_ids":  getScheduledTriggerTenantIDsSchema(),
+		"tenant_tags": getScheduledTriggerTenantTagsSchema(),
+	}
+}
+
+func getDaysOfWeekSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "A list of days of the week on which the trigger runs. Valid days are `Monday`, `Tuesday`, `Wednesday`, `Thursday`, `Friday`, `Saturday`, or `Sunday`.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(daysOfWeek, false)),
+		},
+		MinItems: 1,
+		Required: true,
+		Type:     schema.TypeList,
+	}
+}
+
+func getScheduleTimeSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description:      description,
+		DiffSuppressFunc: suppressEquivalentTimeDiffs,
+		Optional:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+	}
+}
+
+func getScheduleTimeZoneSchema() *schema.Schema {
+	return &schema.Schema{
+		Default:          "UTC",
+		Description:      "The time zone of this schedule. Both IANA (e.g. `Australia/Brisbane`) and Windows (e.g. `E. Australia Standard Time`) time zone identifiers are supported.",
+		Optional:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validateTimeZone,
+	}
+}
+
+func getScheduledTriggerTenantIDsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "A list of tenant IDs for which the action is run.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+}
+
+func getScheduledTriggerTenantTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "A list of tenant tags (in the format `TagSet/Tag`) that select the tenants for which the action is run.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+}
+
+func getScheduledTriggerVariablesSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "A JSON object of prompted variable values that are used by the deployment.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
+func setProjectScheduledTrigger(ctx context.Context, d *schema.ResourceData, projectScheduledTrigger *scheduledProjectTrigger) error {
+	d.Set("description", projectScheduledTrigger.Description)
+	d.Set("is_disabled", projectScheduledTrigger.IsDisabled)
+	d.Set("name", projectScheduledTrigger.Name)
+	d.Set("project_id", projectScheduledTrigger.ProjectID)
+	d.Set("space_id", projectScheduledTrigger.SpaceID)
+
+	actionKey, flattenedAction := flattenScheduledTriggerAction(projectScheduledTrigger.Action)
+	if len(actionKey) == 0 {
+		return fmt.Errorf("project trigger (%s) has an action that is not supported by scheduled triggers", projectScheduledTrigger.ID)
+	}
+
+	for _, key := range scheduledTriggerActions {
+		value := []interface{}{}
+		if key == actionKey {
+			value = flattenedAction
+		}
+
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	scheduleKey, flattenedSchedule := flattenScheduledTriggerFilter(projectScheduledTrigger.Filter)
+	if len(scheduleKey) == 0 {
+		return fmt.Errorf("project trigger (%s) is not a scheduled trigger", projectScheduledTrigger.ID)
+	}
+
+	for _, key := range scheduledTriggerSchedules {
+		value := []interface{}{}
+		if key == scheduleKey {
+			value = flattenedSchedule
+		}
+
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	d.SetId(projectScheduledTrigger.ID)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDaysPerMonthSchedule(t *testing.T) {
+	flattenedSchedule := map[string]interface{}{
+		"date_of_month":         "",
+		"day_number_of_month":   "",
+		"day_of_week":           "",
+		"monthly_schedule_type": "DateOfMonth",
+	}
+	require.Error(t, validateDaysPerMonthSchedule(flattenedSchedule))
+
+	flattenedSchedule["date_of_month"] = "L"
+	require.NoError(t, validateDaysPerMonthSchedule(flattenedSchedule))
+
+	flattenedSchedule["monthly_schedule_type"] = "DayOfMonth"
+	require.Error(t, validateDaysPerMonthSchedule(flattenedSchedule))
+
+	flattenedSchedule["day_number_of_month"] = "2"
+	require.Error(t, validateDaysPerMonthSchedule(flattenedSchedule))
+
+	flattenedSchedule["day_of_week"] = "Tuesday"
+	require.NoError(t, validateDaysPerMonthSchedule(flattenedSchedule))
+}
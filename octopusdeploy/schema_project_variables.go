@@ -0,0 +1,286 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// expandProjectVariables converts the declared variables into the variables of
+// a variable set. The IDs of existing variables with the same name and scope
+// are retained so that unchanged variables keep their identity.
+func expandProjectVariables(flattenedVariables []interface{}, existingVariables []*octopusdeploy.Variable) ([]*octopusdeploy.Variable, error) {
+	variables := []*octopusdeploy.Variable{}
+	usedIDs := map[string]bool{}
+
+	for i, v := range flattenedVariables {
+		flattenedVariable := v.(map[string]interface{})
+
+		variable := octopusdeploy.NewVariable(flattenedVariable["name"].(string))
+		variable.Description = flattenedVariable["description"].(string)
+		variable.IsEditable = flattenedVariable["is_editable"].(bool)
+		variable.Scope = expandVariableScope(flattenedVariable["scope"])
+		variable.Type = flattenedVariable["type"].(string)
+		variable.Prompt = expandProjectVariablePrompt(flattenedVariable["prompt"].([]interface{}))
+
+		value := flattenedVariable["value"].(string)
+		sensitiveValue := flattenedVariable["sensitive_value"].(string)
+		if variable.Type == "Sensitive" {
+			if len(value) > 0 {
+				return nil, fmt.Errorf("variable %d (%s) is sensitive; use sensitive_value instead of value", i, variable.Name)
+			}
+			variable.IsSensitive = true
+			variable.Value = sensitiveValue
+		} else {
+			if len(sensitiveValue) > 0 {
+				return nil, fmt.Errorf("variable %d (%s) is not sensitive; use value instead of sensitive_value", i, variable.Name)
+			}
+			variable.Value = value
+		}
+
+		key := getProjectVariableKey(variable)
+		for _, existingVariable := range existingVariables {
+			if !usedIDs[existingVariable.GetID()] && getProjectVariableKey(existingVariable) == key {
+				variable.ID = existingVariable.GetID()
+				usedIDs[variable.ID] = true
+				break
+			}
+		}
+
+		variables = append(variables, variable)
+	}
+
+	return variables, nil
+}
+
+func expandProjectVariablePrompt(flattenedPrompt []interface{}) *octopusdeploy.VariablePromptOptions {
+	if len(flattenedPrompt) == 0 || flattenedPrompt[0] == nil {
+		return nil
+	}
+
+	prompt := flattenedPrompt[0].(map[string]interface{})
+	return &octopusdeploy.VariablePromptOptions{
+		Description: prompt["description"].(string),
+		Label:       prompt["label"].(string),
+		Required:    prompt["is_required"].(bool),
+	}
+}
+
+// flattenProjectVariables converts the variables of a variable set in the order
+// of the variables in the state (by ID). Variables that are not in the state
+// are appended in a deterministic order so that they are removed on the next
+// apply.
+func flattenProjectVariables(variables []*octopusdeploy.Variable, flattenedVariables []interface{}) []interface{} {
+	stateVariables := map[string]map[string]interface{}{}
+	order := []string{}
+	for _, v := range flattenedVariables {
+		flattenedVariable := v.(map[string]interface{})
+		id := flattenedVariable["id"].(string)
+		if len(id) > 0 {
+			stateVariables[id] = flattenedVariable
+			order = append(order, id)
+		}
+	}
+
+	sortedVariables := make([]*octopusdeploy.Variable, len(variables))
+	copy(sortedVariables, variables)
+	sort.SliceStable(sortedVariables, func(i, j int) bool {
+		return getProjectVariableKey(sortedVariables[i]) < getProjectVariableKey(sortedVariables[j])
+	})
+
+	position := map[string]int{}
+	for i, id := range order {
+		position[id] = i
+	}
+
+	sort.SliceStable(sortedVariables, func(i, j int) bool {
+		pi, iok := position[sortedVariables[i].GetID()]
+		pj, jok := position[sortedVariables[j].GetID()]
+		if iok && jok {
+			return pi < pj
+		}
+		return iok && !jok
+	})
+
+	result := []interface{}{}
+	for _, variable := range sortedVariables {
+		flattenedVariable := map[string]interface{}{
+			"description":     variable.Description,
+			"id":              variable.GetID(),
+			"is_editable":     variable.IsEditable,
+			"name":            variable.Name,
+			"prompt":          flattenProjectVariablePrompt(variable.Prompt),
+			"scope":           flattenProjectVariableScope(variable.Scope),
+			"sensitive_value": "",
+			"type":            variable.Type,
+			"value":           variable.Value,
+		}
+
+		stateVariable, ok := stateVariables[variable.GetID()]
+
+		// the scope in the state is retained if it has the same values so that
+		// the order in which they are declared does not produce a diff
+		if ok && getProjectVariableKey(&octopusdeploy.Variable{Name: variable.Name, Scope: expandVariableScope(stateVariable["scope"])}) == getProjectVariableKey(variable) {
+			flattenedVariable["scope"] = stateVariable["scope"]
+		}
+
+		if variable.IsSensitive {
+			// the value of a sensitive variable is never returned; the value
+			// in the state is retained instead
+			flattenedVariable["value"] = ""
+			if ok {
+				flattenedVariable["sensitive_value"] = stateVariable["sensitive_value"]
+			}
+		}
+
+		result = append(result, flattenedVariable)
+	}
+
+	return result
+}
+
+func flattenProjectVariablePrompt(prompt *octopusdeploy.VariablePromptOptions) []interface{} {
+	if prompt == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"description": prompt.Description,
+		"is_required": prompt.Required,
+		"label":       prompt.Label,
+	}}
+}
+
+// flattenProjectVariableScope converts a scope with sorted values so that the
+// order in which the server returns them is deterministic.
+func flattenProjectVariableScope(scope octopusdeploy.VariableScope) []interface{} {
+	if scope.IsEmpty() {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"actions":      getSortedStrings(scope.Actions),
+		"channels":     getSortedStrings(scope.Channels),
+		"environments": getSortedStrings(scope.Environments),
+		"machines":     getSortedStrings(scope.Machines),
+		"roles":        getSortedStrings(scope.Roles),
+		"tenant_tags":  getSortedStrings(scope.TenantTags),
+	}}
+}
+
+// getProjectVariableKey returns a key that identifies a variable by its name
+// and scope.
+func getProjectVariableKey(variable *octopusdeploy.Variable) string {
+	scope := variable.Scope
+	return strings.Join([]string{
+		variable.Name,
+		strings.Join(getSortedStrings(scope.Actions), ","),
+		strings.Join(getSortedStrings(scope.Channels), ","),
+		strings.Join(getSortedStrings(scope.Environments), ","),
+		strings.Join(getSortedStrings(scope.Machines), ","),
+		strings.Join(getSortedStrings(scope.Roles), ","),
+		strings.Join(getSortedStrings(scope.TenantTags), ","),
+	}, "|")
+}
+
+func getSortedStrings(values []string) []string {
+	sortedValues := make([]string, len(values))
+	copy(sortedValues, values)
+	sort.Strings(sortedValues)
+	return sortedValues
+}
+
+func getProjectVariablesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"owner_id": {
+			Description: "The ID of the project or library variable set that owns the variables.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"variable": {
+			Description: "A variable of the variable set. Variables that are not declared are removed from the variable set.",
+			Elem:        &schema.Resource{Schema: getProjectVariableSchema()},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"version": {
+			Computed:    true,
+			Description: "The version of the variable set.",
+			Type:        schema.TypeInt,
+		},
+	}
+}
+
+func getProjectVariableSchema() map[string]*schema.Schema {
+	variableType := getVariableTypeSchema()
+	variableType.Default = "String"
+	variableType.Optional = true
+	variableType.Required = false
+
+	return map[string]*schema.Schema{
+		"description": getDescriptionSchema(),
+		"id": {
+			Computed:    true,
+			Description: "The ID of this variable.",
+			Type:        schema.TypeString,
+		},
+		"is_editable": {
+			Default:     true,
+			Description: "Indicates whether or not this variable is considered editable.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": {
+			Description:      "The name of this variable.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"prompt": {
+			Elem:     &schema.Resource{Schema: getVariablePromptOptionsSchema()},
+			MaxItems: 1,
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"scope": {
+			Elem:     &schema.Resource{Schema: getVariableScopeSchema()},
+			MaxItems: 1,
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"sensitive_value": {
+			Description: "The value of this variable if its type is `Sensitive`.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"type": variableType,
+		"value": {
+			Description: "The value of this variable.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setProjectVariables(ctx context.Context, d *schema.ResourceData, variableSet *octopusdeploy.VariableSet) error {
+	d.Set("owner_id", variableSet.OwnerID)
+	d.Set("space_id", variableSet.SpaceID)
+	d.Set("version", variableSet.Version)
+
+	if err := d.Set("variable", flattenProjectVariables(variableSet.Variables, d.Get("variable").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting variable: %s", err)
+	}
+
+	d.SetId(variableSet.OwnerID)
+
+	return nil
+}
@@ -7,14 +7,17 @@ import (
 
 func getQueryAccountType() *schema.Schema {
 	return &schema.Schema{
-		Description: "A filter to search by a list of account types.  Valid account types are `AmazonWebServicesAccount`, `AmazonWebServicesRoleAccount`, `AzureServicePrincipal`, `AzureSubscription`, `None`, `SshKeyPair`, `Token`, or `UsernamePassword`.",
+		Description: "A filter to search by a list of account types.  Valid account types are `AmazonWebServicesAccount`, `AmazonWebServicesOidcAccount`, `AmazonWebServicesRoleAccount`, `AzureOidc`, `AzureServicePrincipal`, `AzureSubscription`, `GoogleCloudAccount`, `None`, `SshKeyPair`, `Token`, or `UsernamePassword`.",
 		Optional:    true,
 		Type:        schema.TypeString,
 		ValidateDiagFunc: validateValueFunc([]string{
 			"AmazonWebServicesAccount",
+			"AmazonWebServicesOidcAccount",
 			"AmazonWebServicesRoleAccount",
+			"AzureOidc",
 			"AzureServicePrincipal",
 			"AzureSubscription",
+			"GoogleCloudAccount",
 			"None",
 			"SshKeyPair",
 			"Token",
@@ -89,18 +92,22 @@ func getQueryEnvironments() *schema.Schema {
 
 func getQueryFeedType() *schema.Schema {
 	return &schema.Schema{
-		Description: "A filter to search by feed type. Valid feed types are `AwsElasticContainerRegistry`, `BuiltIn`, `Docker`, `GitHub`, `Helm`, `Maven`, `NuGet`, or `OctopusProject`.",
+		Description: "A filter to search by feed type. Valid feed types are `ArtifactoryGeneric`, `AwsElasticContainerRegistry`, `BuiltIn`, `Docker`, `GitHub`, `GoogleContainerRegistry`, `Helm`, `Maven`, `NuGet`, `OciRegistry`, `OctopusProject`, or `S3`.",
 		Optional:    true,
 		Type:        schema.TypeString,
 		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"ArtifactoryGeneric",
 			"AwsElasticContainerRegistry",
 			"BuiltIn",
 			"Docker",
 			"GitHub",
+			"GoogleContainerRegistry",
 			"Helm",
 			"Maven",
 			"NuGet",
+			"OciRegistry",
 			"OctopusProject",
+			"S3",
 		}, false)),
 	}
 }
@@ -297,3 +304,15 @@ func getQueryThumbprint() *schema.Schema {
 		Type:        schema.TypeString,
 	}
 }
+
+func getQueryWorkerPoolType() *schema.Schema {
+	return &schema.Schema{
+		Description: "A filter to search by worker pool type. Valid worker pool types are `DynamicWorkerPool` or `StaticWorkerPool`.",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"DynamicWorkerPool",
+			"StaticWorkerPool",
+		}, false)),
+	}
+}
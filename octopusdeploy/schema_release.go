@@ -0,0 +1,389 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/OctopusDeploy/go-octopusdeploy/uritemplates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// versionControlledRelease represents a release along with the git reference
+// from which it was created. The client does not support the latter.
+type versionControlledRelease struct {
+	VersionControlReference *versionControlReference `json:"VersionControlReference,omitempty"`
+
+	*octopusdeploy.Release
+}
+
+type versionControlReference struct {
+	GitCommit string `json:"GitCommit,omitempty"`
+	GitRef    string `json:"GitRef"`
+}
+
+// releaseTemplate represents the packages and the next version of a release
+// as suggested by the deployment process of a project.
+type releaseTemplate struct {
+	NextVersionIncrement string                    `json:"NextVersionIncrement"`
+	Packages             []*releaseTemplatePackage `json:"Packages"`
+}
+
+type releaseTemplatePackage struct {
+	ActionName           string `json:"ActionName"`
+	FeedID               string `json:"FeedId"`
+	IsResolvable         bool   `json:"IsResolvable"`
+	PackageID            string `json:"PackageId"`
+	PackageReferenceName string `json:"PackageReferenceName"`
+	StepName             string `json:"StepName"`
+}
+
+type packageVersions struct {
+	Items []*octopusdeploy.PackageVersion `json:"Items"`
+	octopusdeploy.PagedResults
+}
+
+func expandRelease(d *schema.ResourceData) *versionControlledRelease {
+	release := &versionControlledRelease{
+		Release: octopusdeploy.NewRelease(d.Get("channel_id").(string), d.Get("project_id").(string), d.Get("version").(string)),
+	}
+	release.ID = d.Id()
+	release.IgnoreChannelRules = d.Get("ignore_channel_rules").(bool)
+	release.ReleaseNotes = d.Get("release_notes").(string)
+
+	if v, ok := d.GetOk("git_ref"); ok {
+		release.VersionControlReference = &versionControlReference{GitRef: v.(string)}
+	}
+
+	return release
+}
+
+func expandReleasePackages(packages []interface{}) []*octopusdeploy.SelectedPackage {
+	selectedPackages := []*octopusdeploy.SelectedPackage{}
+	for _, v := range packages {
+		flattenedPackage := v.(map[string]interface{})
+		selectedPackages = append(selectedPackages, &octopusdeploy.SelectedPackage{
+			ActionName:           flattenedPackage["action_name"].(string),
+			PackageReferenceName: flattenedPackage["package_reference_name"].(string),
+			Version:              flattenedPackage["version"].(string),
+		})
+	}
+
+	return selectedPackages
+}
+
+func flattenReleaseSelectedPackages(selectedPackages []*octopusdeploy.SelectedPackage) []interface{} {
+	flattenedSelectedPackages := []interface{}{}
+	for _, selectedPackage := range selectedPackages {
+		flattenedSelectedPackages = append(flattenedSelectedPackages, map[string]interface{}{
+			"action_name":            selectedPackage.ActionName,
+			"package_reference_name": selectedPackage.PackageReferenceName,
+			"step_name":              selectedPackage.StepName,
+			"version":                selectedPackage.Version,
+		})
+	}
+
+	return flattenedSelectedPackages
+}
+
+func getReleaseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"assembled": {
+			Computed:    true,
+			Description: "The time at which this release was created.",
+			Type:        schema.TypeString,
+		},
+		"channel_id": {
+			Computed:    true,
+			Description: "The ID of the channel of this release. The default channel of the project is used if omitted.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"delete_on_destroy": {
+			Default:     false,
+			Description: "Indicates whether or not this release is deleted from Octopus Deploy when this resource is destroyed. Otherwise, it is only removed from the Terraform state.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"git_ref": {
+			Description:      "The git reference (e.g. `refs/heads/main`) from which the release of a version-controlled project is created. It cannot be specified for projects that are not version-controlled.",
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"id": getIDSchema(),
+		"ignore_channel_rules": {
+			Description: "Indicates whether or not the version rules of the channel are ignored when the release is created.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"package": {
+			Description: "The version of a package of the deployment process. The latest version available in the feed (that satisfies the rules of the channel) is selected for every package that is omitted.",
+			Elem:        &schema.Resource{Schema: getReleasePackageSchema()},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"project_id": {
+			Description: "The ID of the project of this release.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"release_notes": {
+			Description: "The release notes of this release.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"selected_packages": {
+			Computed:    true,
+			Description: "The versions of all packages that were selected for this release.",
+			Elem:        &schema.Resource{Schema: getReleaseSelectedPackageSchema()},
+			Type:        schema.TypeList,
+		},
+		"space_id": getSpaceIDSchema(),
+		"version": {
+			Computed:    true,
+			Description: "The version of this release. The version is computed from the versioning strategy of the project if omitted.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func getReleasePackageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action_name": {
+			Description:      "The name of the action that references the package.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"package_reference_name": {
+			Description: "The name of the package reference. Omit for the primary package of the action.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"version": {
+			Description:      "The version of the package.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+	}
+}
+
+func getReleaseSelectedPackageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action_name": {
+			Computed: true,
+			Type:     schema.TypeString,
+		},
+		"package_reference_name": {
+			Computed: true,
+			Type:     schema.TypeString,
+		},
+		"step_name": {
+			Computed: true,
+			Type:     schema.TypeString,
+		},
+		"version": {
+			Computed: true,
+			Type:     schema.TypeString,
+		},
+	}
+}
+
+func setRelease(ctx context.Context, d *schema.ResourceData, release *versionControlledRelease) error {
+	d.Set("assembled", release.Assembled.Format(time.RFC3339))
+	d.Set("channel_id", release.ChannelID)
+	d.Set("project_id", release.ProjectID)
+	d.Set("release_notes", release.ReleaseNotes)
+	d.Set("space_id", release.SpaceID)
+	d.Set("version", release.Version)
+
+	if release.VersionControlReference != nil {
+		d.Set("git_ref", release.VersionControlReference.GitRef)
+	}
+
+	if err := d.Set("selected_packages", flattenReleaseSelectedPackages(release.SelectedPackages)); err != nil {
+		return fmt.Errorf("error setting selected_packages: %s", err)
+	}
+
+	d.SetId(release.GetID())
+
+	return nil
+}
+
+func getRelease(client *octopusdeploy.Client, id string) (*versionControlledRelease, error) {
+	release := &versionControlledRelease{Release: &octopusdeploy.Release{}}
+	if err := apiGet(client.Releases.Sling, client.Releases.BasePath+"/"+id, release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// getReleaseTemplate returns the release template of the deployment process of
+// a project. The deployment process of a version-controlled project is read
+// from the git reference provided as input.
+func getReleaseTemplate(client *octopusdeploy.Client, project *octopusdeploy.Project, channelID string, gitRef string) (*releaseTemplate, error) {
+	path := client.DeploymentProcesses.BasePath + "/" + project.DeploymentProcessID + "/template"
+	if len(gitRef) > 0 {
+		path = client.Projects.BasePath + "/" + project.GetID() + "/" + url.PathEscape(gitRef) + "/deploymentprocesses/template"
+	}
+
+	template := &releaseTemplate{}
+	if err := apiGet(client.Projects.Sling, path+"?channel="+url.QueryEscape(channelID), template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// getLatestPackageVersion returns the latest version of a package in a feed.
+// The version range and pre-release tag of the channel rule provided as input
+// are honoured (if any).
+func getLatestPackageVersion(client *octopusdeploy.Client, feedID string, packageID string, channelRule *octopusdeploy.ChannelRule) (string, error) {
+	feed := &octopusdeploy.FeedResource{}
+	if err := apiGet(client.Feeds.Sling, client.Feeds.BasePath+"/"+feedID, feed); err != nil {
+		return "", err
+	}
+
+	template, err := uritemplates.Parse(feed.Links["SearchPackageVersionsTemplate"])
+	if err != nil {
+		return "", err
+	}
+
+	values := map[string]interface{}{
+		"packageId": packageID,
+		"take":      1,
+	}
+
+	if channelRule != nil {
+		if len(channelRule.VersionRange) > 0 {
+			values["versionRange"] = channelRule.VersionRange
+		}
+
+		if len(channelRule.Tag) > 0 {
+			values["includePreRelease"] = true
+			values["preReleaseTag"] = channelRule.Tag
+		}
+	}
+
+	path, err := template.Expand(values)
+	if err != nil {
+		return "", err
+	}
+
+	versions := &packageVersions{}
+	if err := apiGet(client.Feeds.Sling, path, versions); err != nil {
+		return "", err
+	}
+
+	if len(versions.Items) == 0 {
+		return "", fmt.Errorf("no version of package %s in feed %s satisfies the rules of the channel", packageID, feedID)
+	}
+
+	return versions.Items[0].Version, nil
+}
+
+// getChannelRule returns the rule of a channel that applies to a package
+// reference of an action (if any).
+func getChannelRule(channel *octopusdeploy.Channel, actionName string, packageReferenceName string) *octopusdeploy.ChannelRule {
+	for i, rule := range channel.Rules {
+		for _, actionPackage := range rule.ActionPackages {
+			if actionPackage.DeploymentAction == actionName && actionPackage.PackageReference == packageReferenceName {
+				return &channel.Rules[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveReleasePackages selects a version for every package of a release
+// template. Pinned versions take precedence over the latest version available
+// in the feed of a package.
+func resolveReleasePackages(client *octopusdeploy.Client, channel *octopusdeploy.Channel, template *releaseTemplate, pinnedPackages []*octopusdeploy.SelectedPackage, ignoreChannelRules bool) ([]*octopusdeploy.SelectedPackage, error) {
+	selectedPackages := []*octopusdeploy.SelectedPackage{}
+	for _, templatePackage := range template.Packages {
+		selectedPackage := &octopusdeploy.SelectedPackage{
+			ActionName:           templatePackage.ActionName,
+			PackageReferenceName: templatePackage.PackageReferenceName,
+			StepName:             templatePackage.StepName,
+		}
+
+		for _, pinnedPackage := range pinnedPackages {
+			if pinnedPackage.ActionName == templatePackage.ActionName && pinnedPackage.PackageReferenceName == templatePackage.PackageReferenceName {
+				selectedPackage.Version = pinnedPackage.Version
+			}
+		}
+
+		if len(selectedPackage.Version) == 0 {
+			if !templatePackage.IsResolvable {
+				return nil, fmt.Errorf("the version of package %s of action %s cannot be resolved from its feed; specify its version instead", templatePackage.PackageID, templatePackage.ActionName)
+			}
+
+			var channelRule *octopusdeploy.ChannelRule
+			if !ignoreChannelRules {
+				channelRule = getChannelRule(channel, templatePackage.ActionName, templatePackage.PackageReferenceName)
+			}
+
+			version, err := getLatestPackageVersion(client, templatePackage.FeedID, templatePackage.PackageID, channelRule)
+			if err != nil {
+				return nil, err
+			}
+			selectedPackage.Version = version
+		}
+
+		selectedPackages = append(selectedPackages, selectedPackage)
+	}
+
+	for _, pinnedPackage := range pinnedPackages {
+		if !isReleaseTemplatePackage(template, pinnedPackage) {
+			return nil, fmt.Errorf("the deployment process does not reference a package named '%s' in action %s", pinnedPackage.PackageReferenceName, pinnedPackage.ActionName)
+		}
+	}
+
+	return selectedPackages, nil
+}
+
+func isReleaseTemplatePackage(template *releaseTemplate, selectedPackage *octopusdeploy.SelectedPackage) bool {
+	for _, templatePackage := range template.Packages {
+		if templatePackage.ActionName == selectedPackage.ActionName && templatePackage.PackageReferenceName == selectedPackage.PackageReferenceName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getReleaseVersion returns the version of a release as defined by the
+// versioning strategy of a project (as flattened by flattenVersioningStrategy);
+// either the version of its donor package or the next version suggested by the
+// release template.
+func getReleaseVersion(flattenedVersioningStrategy []interface{}, template *releaseTemplate, selectedPackages []*octopusdeploy.SelectedPackage) string {
+	if len(flattenedVersioningStrategy) > 0 && flattenedVersioningStrategy[0] != nil {
+		versioningStrategy := flattenedVersioningStrategy[0].(map[string]interface{})
+		if donorPackages, ok := versioningStrategy["donor_package"].([]interface{}); ok && len(donorPackages) > 0 {
+			donorPackage := donorPackages[0].(map[string]interface{})
+			deploymentAction := donorPackage["deployment_action"].(string)
+			packageReference := donorPackage["package_reference"].(string)
+			for _, selectedPackage := range selectedPackages {
+				if len(deploymentAction) > 0 && selectedPackage.ActionName == deploymentAction && selectedPackage.PackageReferenceName == packageReference {
+					return selectedPackage.Version
+				}
+			}
+		}
+	}
+
+	return template.NextVersionIncrement
+}
@@ -0,0 +1,24 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReleaseVersion(t *testing.T) {
+	template := &releaseTemplate{NextVersionIncrement: "1.0.1"}
+	selectedPackages := []*octopusdeploy.SelectedPackage{
+		{ActionName: "Deploy", PackageReferenceName: "", Version: "2.3.4"},
+	}
+
+	versioningStrategy := octopusdeploy.VersioningStrategy{Template: "#{Octopus.Version.LastMajor}.#{Octopus.Version.LastMinor}.#{Octopus.Version.NextPatch}"}
+	require.Equal(t, "1.0.1", getReleaseVersion(flattenVersioningStrategy(versioningStrategy), template, selectedPackages))
+
+	versioningStrategy = octopusdeploy.VersioningStrategy{DonorPackage: &octopusdeploy.DeploymentActionPackage{DeploymentAction: "Deploy"}}
+	require.Equal(t, "2.3.4", getReleaseVersion(flattenVersioningStrategy(versioningStrategy), template, selectedPackages))
+
+	versioningStrategy = octopusdeploy.VersioningStrategy{DonorPackage: &octopusdeploy.DeploymentActionPackage{DeploymentAction: "Other"}}
+	require.Equal(t, "1.0.1", getReleaseVersion(flattenVersioningStrategy(versioningStrategy), template, selectedPackages))
+}
@@ -0,0 +1,163 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandRunbook(d *schema.ResourceData) *octopusdeploy.Runbook {
+	name := d.Get("name").(string)
+	projectID := d.Get("project_id").(string)
+
+	runbook := octopusdeploy.NewRunbook(name, projectID)
+	runbook.ID = d.Id()
+
+	if v, ok := d.GetOk("connectivity_policy"); ok {
+		runbook.ConnectivityPolicy = expandConnectivityPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("default_guided_failure_mode"); ok {
+		runbook.DefaultGuidedFailureMode = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		runbook.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("environment_scope"); ok {
+		runbook.EnvironmentScope = v.(string)
+	}
+
+	if v, ok := d.GetOk("environments"); ok {
+		runbook.Environments = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("multi_tenancy_mode"); ok {
+		runbook.MultiTenancyMode = v.(string)
+	}
+
+	if v, ok := d.GetOk("run_retention_policy"); ok {
+		if retentionPolicy := expandRunbookRetentionPeriod(v); retentionPolicy != nil {
+			runbook.RunRetentionPolicy = retentionPolicy
+		}
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		runbook.SpaceID = v.(string)
+	}
+
+	return runbook
+}
+
+func getRunbookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connectivity_policy": {
+			Computed: true,
+			Elem:     &schema.Resource{Schema: getConnectivityPolicySchema()},
+			MaxItems: 1,
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"default_guided_failure_mode": {
+			Default:     "EnvironmentDefault",
+			Description: "The guided failure mode of this runbook. Valid modes are `EnvironmentDefault`, `Off`, or `On`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"EnvironmentDefault",
+				"Off",
+				"On",
+			}, false)),
+		},
+		"description": getDescriptionSchema(),
+		"environment_scope": {
+			Default:     "All",
+			Description: "Determines which environments this runbook can be run in. Valid scopes are `All`, `FromProjectLifecycles`, or `Specified`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"All",
+				"FromProjectLifecycles",
+				"Specified",
+			}, false)),
+		},
+		"environments": {
+			Description: "A list of environment IDs that this runbook can be run in. Only used when `environment_scope` is `Specified`.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"id": getIDSchema(),
+		"multi_tenancy_mode": {
+			Default:     "Untenanted",
+			Description: "The tenanted deployment mode of this runbook. Valid modes are `Untenanted`, `TenantedOrUntenanted`, or `Tenanted`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"Untenanted",
+				"TenantedOrUntenanted",
+				"Tenanted",
+			}, false)),
+		},
+		"name": getNameSchema(true),
+		"project_id": {
+			Description: "The project ID associated with this runbook.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"published_runbook_snapshot_id": {
+			Computed:    true,
+			Description: "The ID of the published snapshot of this runbook.",
+			Type:        schema.TypeString,
+		},
+		"run_retention_policy": {
+			Computed:    true,
+			Description: "The retention policy for the runs of this runbook.",
+			Elem:        &schema.Resource{Schema: getRunbookRetentionPeriodSchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"runbook_process_id": {
+			Computed:    true,
+			Description: "The ID of the runbook process of this runbook.",
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+	}
+}
+
+func setRunbook(ctx context.Context, d *schema.ResourceData, runbook *octopusdeploy.Runbook) error {
+	if err := d.Set("connectivity_policy", flattenConnectivityPolicy(runbook.ConnectivityPolicy)); err != nil {
+		return fmt.Errorf("error setting connectivity_policy: %s", err)
+	}
+
+	d.Set("default_guided_failure_mode", runbook.DefaultGuidedFailureMode)
+	d.Set("description", runbook.Description)
+	d.Set("environment_scope", runbook.EnvironmentScope)
+
+	if err := d.Set("environments", runbook.Environments); err != nil {
+		return fmt.Errorf("error setting environments: %s", err)
+	}
+
+	d.Set("multi_tenancy_mode", runbook.MultiTenancyMode)
+	d.Set("name", runbook.Name)
+	d.Set("project_id", runbook.ProjectID)
+	d.Set("published_runbook_snapshot_id", runbook.PublishedRunbookSnapshotID)
+
+	if err := d.Set("run_retention_policy", flattenRunbookRetentionPeriod(runbook.RunRetentionPolicy)); err != nil {
+		return fmt.Errorf("error setting run_retention_policy: %s", err)
+	}
+
+	d.Set("runbook_process_id", runbook.RunbookProcessID)
+	d.Set("space_id", runbook.SpaceID)
+
+	d.SetId(runbook.GetID())
+
+	return nil
+}
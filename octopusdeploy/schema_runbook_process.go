@@ -0,0 +1,86 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandRunbookProcess(d *schema.ResourceData) *octopusdeploy.RunbookProcess {
+	runbookProcess := octopusdeploy.NewRunbookProcess()
+	runbookProcess.ID = d.Id()
+	runbookProcess.RunbookID = d.Get("runbook_id").(string)
+	runbookProcess.Steps = []*octopusdeploy.DeploymentStep{}
+
+	if v, ok := d.GetOk("project_id"); ok {
+		runbookProcess.ProjectID = v.(string)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		runbookProcess.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("step"); ok {
+		steps := v.([]interface{})
+		for _, step := range steps {
+			runbookProcess.Steps = append(runbookProcess.Steps, expandDeploymentStep(step.(map[string]interface{})))
+		}
+	}
+
+	return runbookProcess
+}
+
+func getRunbookProcessSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"last_snapshot_id": {
+			Computed: true,
+			Type:     schema.TypeString,
+		},
+		"project_id": {
+			Computed:    true,
+			Description: "The project ID associated with this runbook process.",
+			Type:        schema.TypeString,
+		},
+		"runbook_id": {
+			Description: "The runbook ID associated with this runbook process.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"step":     getDeploymentStepSchema(),
+		"version": {
+			Computed: true,
+			Type:     schema.TypeInt,
+		},
+	}
+}
+
+func setRunbookProcess(ctx context.Context, d *schema.ResourceData, runbookProcess *octopusdeploy.RunbookProcess) error {
+	d.Set("last_snapshot_id", runbookProcess.LastSnapshotID)
+	d.Set("project_id", runbookProcess.ProjectID)
+	d.Set("runbook_id", runbookProcess.RunbookID)
+	d.Set("space_id", runbookProcess.SpaceID)
+
+	if runbookProcess.Version != nil {
+		d.Set("version", *runbookProcess.Version)
+	}
+
+	steps := []octopusdeploy.DeploymentStep{}
+	for _, step := range runbookProcess.Steps {
+		if step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	if err := d.Set("step", flattenDeploymentSteps(steps)); err != nil {
+		return fmt.Errorf("error setting step: %s", err)
+	}
+
+	d.SetId(runbookProcess.GetID())
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandRunbookRetentionPeriod(values interface{}) *octopusdeploy.RunbookRetentionPeriod {
+	flattenedValues := values.([]interface{})
+	if len(flattenedValues) == 0 || flattenedValues[0] == nil {
+		return nil
+	}
+
+	flattenedRetentionPeriod := flattenedValues[0].(map[string]interface{})
+
+	return &octopusdeploy.RunbookRetentionPeriod{
+		QuantityToKeep:    int32(flattenedRetentionPeriod["quantity_to_keep"].(int)),
+		ShouldKeepForever: flattenedRetentionPeriod["should_keep_forever"].(bool),
+	}
+}
+
+func flattenRunbookRetentionPeriod(runbookRetentionPeriod *octopusdeploy.RunbookRetentionPeriod) []interface{} {
+	if runbookRetentionPeriod == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"quantity_to_keep":    int(runbookRetentionPeriod.QuantityToKeep),
+		"should_keep_forever": runbookRetentionPeriod.ShouldKeepForever,
+	}}
+}
+
+func getRunbookRetentionPeriodSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"quantity_to_keep": {
+			Default:          100,
+			Description:      "The number of runs to keep.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"should_keep_forever": {
+			Default:     false,
+			Description: "Indicates whether or not all runs should be kept forever.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
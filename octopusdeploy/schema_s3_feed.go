@@ -0,0 +1,90 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const feedTypeS3 = octopusdeploy.FeedType("S3")
+
+func expandS3Feed(d *schema.ResourceData) *extendedFeedResource {
+	name := d.Get("name").(string)
+
+	feed := newExtendedFeedResource(name, feedTypeS3)
+	feed.ID = d.Id()
+	feed.UseMachineCredentials = d.Get("use_machine_credentials").(bool)
+
+	if v, ok := d.GetOk("access_key"); ok {
+		feed.AccessKey = v.(string)
+	}
+
+	if v, ok := d.GetOk("package_acquisition_location_options"); ok {
+		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("secret_key"); ok {
+		feed.SecretKey = octopusdeploy.NewSensitiveValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		feed.SpaceID = v.(string)
+	}
+
+	return feed
+}
+
+func getS3FeedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_key": {
+			Description:  "The AWS access key to use when authenticating against Amazon Web Services.",
+			Optional:     true,
+			RequiredWith: []string{"secret_key"},
+			Type:         schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"name": {
+			Description:      "A short, memorable, unique name for this feed. Example: ACME Builds.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"package_acquisition_location_options": {
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"secret_key": {
+			Description:  "The AWS secret key to use when authenticating against Amazon Web Services.",
+			Optional:     true,
+			RequiredWith: []string{"access_key"},
+			Sensitive:    true,
+			Type:         schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"use_machine_credentials": {
+			Description: "Indicates whether or not the credentials of the machine (e.g. an instance profile) are used instead of an access key and secret key.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+func setS3Feed(ctx context.Context, d *schema.ResourceData, feed *extendedFeedResource) error {
+	d.Set("access_key", feed.AccessKey)
+	d.Set("name", feed.Name)
+	d.Set("space_id", feed.SpaceID)
+	d.Set("use_machine_credentials", feed.UseMachineCredentials)
+
+	if err := d.Set("package_acquisition_location_options", feed.PackageAcquisitionLocationOptions); err != nil {
+		return fmt.Errorf("error setting package_acquisition_location_options: %s", err)
+	}
+
+	d.SetId(feed.GetID())
+
+	return nil
+}
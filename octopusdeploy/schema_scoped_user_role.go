@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandScopedUserRole(d *schema.ResourceData) *octopusdeploy.ScopedUserRole {
+	scopedUserRole := octopusdeploy.NewScopedUserRole(d.Get("user_role_id").(string))
+	scopedUserRole.ID = d.Id()
+	scopedUserRole.TeamID = d.Get("team_id").(string)
+
+	if v, ok := d.GetOk("environment_ids"); ok {
+		scopedUserRole.EnvironmentIDs = expandArray(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("project_group_ids"); ok {
+		scopedUserRole.ProjectGroupIDs = expandArray(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("project_ids"); ok {
+		scopedUserRole.ProjectIDs = expandArray(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		scopedUserRole.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenant_ids"); ok {
+		scopedUserRole.TenantIDs = expandArray(v.(*schema.Set).List())
+	}
+
+	return scopedUserRole
+}
+
+func getScopedUserRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_ids":   getScopedUserRoleScopeSchema("environments"),
+		"id":                getIDSchema(),
+		"project_group_ids": getScopedUserRoleScopeSchema("project groups"),
+		"project_ids":       getScopedUserRoleScopeSchema("projects"),
+		"space_id": {
+			Computed:    true,
+			Description: "The ID of the space in which the user role is granted. Omit for system user roles.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"team_id": {
+			Description:      "The ID of the team to which the user role is granted.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"tenant_ids": getScopedUserRoleScopeSchema("tenants"),
+		"user_role_id": {
+			Description:      "The ID of the user role that is granted to the team.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+}
+
+func getScopedUserRoleScopeSchema(scope string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The IDs of the %s to which the user role is restricted. The user role applies to all %s if omitted.", scope, scope),
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeSet,
+	}
+}
+
+func setScopedUserRole(ctx context.Context, d *schema.ResourceData, scopedUserRole *octopusdeploy.ScopedUserRole) error {
+	d.Set("space_id", scopedUserRole.SpaceID)
+	d.Set("team_id", scopedUserRole.TeamID)
+	d.Set("user_role_id", scopedUserRole.UserRoleID)
+
+	if err := d.Set("environment_ids", scopedUserRole.EnvironmentIDs); err != nil {
+		return fmt.Errorf("error setting environment_ids: %s", err)
+	}
+
+	if err := d.Set("project_group_ids", scopedUserRole.ProjectGroupIDs); err != nil {
+		return fmt.Errorf("error setting project_group_ids: %s", err)
+	}
+
+	if err := d.Set("project_ids", scopedUserRole.ProjectIDs); err != nil {
+		return fmt.Errorf("error setting project_ids: %s", err)
+	}
+
+	if err := d.Set("tenant_ids", scopedUserRole.TenantIDs); err != nil {
+		return fmt.Errorf("error setting tenant_ids: %s", err)
+	}
+
+	d.SetId(scopedUserRole.GetID())
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Script modules are library variable sets with a content type of
+// "ScriptModule". The body and the language of a script module are stored as
+// variables of its variable set; their names include the name of the script
+// module.
+const (
+	scriptModuleBodyVariablePrefix   = "Octopus.Script.Module["
+	scriptModuleSyntaxVariablePrefix = "Octopus.Script.Module.Language["
+)
+
+func expandScriptModule(d *schema.ResourceData) *octopusdeploy.LibraryVariableSet {
+	name := d.Get("name").(string)
+
+	scriptModule := octopusdeploy.NewLibraryVariableSet(name)
+	scriptModule.ContentType = "ScriptModule"
+	scriptModule.ID = d.Id()
+
+	if v, ok := d.GetOk("description"); ok {
+		scriptModule.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		scriptModule.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("variable_set_id"); ok {
+		scriptModule.VariableSetID = v.(string)
+	}
+
+	return scriptModule
+}
+
+// expandScriptModuleVariables replaces the body and syntax variables of the
+// variable set of a script module with the ones that are defined by the
+// resource.
+func expandScriptModuleVariables(d *schema.ResourceData, variableSet octopusdeploy.VariableSet) octopusdeploy.VariableSet {
+	name := d.Get("name").(string)
+
+	variables := []*octopusdeploy.Variable{}
+	for _, variable := range variableSet.Variables {
+		if strings.HasPrefix(variable.Name, scriptModuleBodyVariablePrefix) || strings.HasPrefix(variable.Name, scriptModuleSyntaxVariablePrefix) {
+			continue
+		}
+		variables = append(variables, variable)
+	}
+
+	bodyVariable := octopusdeploy.NewVariable(scriptModuleBodyVariablePrefix + name + "]")
+	bodyVariable.Value = d.Get("script_body").(string)
+
+	syntaxVariable := octopusdeploy.NewVariable(scriptModuleSyntaxVariablePrefix + name + "]")
+	syntaxVariable.Value = d.Get("script_syntax").(string)
+
+	variableSet.Variables = append(variables, bodyVariable, syntaxVariable)
+
+	return variableSet
+}
+
+func getScriptModuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": getDescriptionSchema(),
+		"id":          getIDSchema(),
+		"name":        getNameSchema(true),
+		"script_body": {
+			Description:      "The body of this script module.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"script_syntax": {
+			Default:     "PowerShell",
+			Description: "The language of this script module. Valid languages are `Bash`, `CSharp`, `FSharp`, `PowerShell`, or `Python`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"Bash",
+				"CSharp",
+				"FSharp",
+				"PowerShell",
+				"Python",
+			}, false)),
+		},
+		"space_id": getSpaceIDSchema(),
+		"variable_set_id": {
+			Computed:    true,
+			Description: "The ID of the variable set that stores the body and the language of this script module.",
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setScriptModule(ctx context.Context, d *schema.ResourceData, scriptModule *octopusdeploy.LibraryVariableSet, variableSet octopusdeploy.VariableSet) error {
+	if scriptModule.ContentType != "ScriptModule" {
+		return fmt.Errorf("library variable set (%s) is not a script module", scriptModule.GetID())
+	}
+
+	d.Set("description", scriptModule.Description)
+	d.Set("name", scriptModule.Name)
+	d.Set("space_id", scriptModule.SpaceID)
+	d.Set("variable_set_id", scriptModule.VariableSetID)
+
+	for _, variable := range variableSet.Variables {
+		switch variable.Name {
+		case scriptModuleBodyVariablePrefix + scriptModule.Name + "]":
+			d.Set("script_body", variable.Value)
+		case scriptModuleSyntaxVariablePrefix + scriptModule.Name + "]":
+			d.Set("script_syntax", variable.Value)
+		}
+	}
+
+	d.SetId(scriptModule.GetID())
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// smtpConfigurationID is the ID of the (singleton) SMTP configuration.
+const smtpConfigurationID = "smtp"
+
+// smtpConfiguration represents the SMTP settings of the Octopus server. The
+// client does not support reading or updating these settings.
+type smtpConfiguration struct {
+	EnableSsl     bool                          `json:"EnableSsl"`
+	ID            string                        `json:"Id,omitempty"`
+	SendEmailFrom string                        `json:"SendEmailFrom"`
+	SmtpHost      string                        `json:"SmtpHost"`
+	SmtpLogin     string                        `json:"SmtpLogin"`
+	SmtpPassword  *octopusdeploy.SensitiveValue `json:"SmtpPassword,omitempty"`
+	SmtpPort      int                           `json:"SmtpPort"`
+	Timeout       int                           `json:"Timeout"`
+}
+
+// newDefaultSMTPConfiguration returns the SMTP settings of a new Octopus
+// server (that is, an unconfigured SMTP server).
+func newDefaultSMTPConfiguration() *smtpConfiguration {
+	return &smtpConfiguration{
+		ID:           smtpConfigurationID,
+		SmtpPassword: octopusdeploy.NewSensitiveValue(""),
+		SmtpPort:     25,
+		Timeout:      12000,
+	}
+}
+
+func expandSMTPConfiguration(d *schema.ResourceData) *smtpConfiguration {
+	return &smtpConfiguration{
+		EnableSsl:     d.Get("enable_ssl").(bool),
+		ID:            smtpConfigurationID,
+		SendEmailFrom: d.Get("send_email_from").(string),
+		SmtpHost:      d.Get("host").(string),
+		SmtpLogin:     d.Get("username").(string),
+		SmtpPassword:  octopusdeploy.NewSensitiveValue(d.Get("password").(string)),
+		SmtpPort:      d.Get("port").(int),
+		Timeout:       d.Get("timeout").(int),
+	}
+}
+
+func getSMTPConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"enable_ssl": {
+			Default:     false,
+			Description: "Indicates whether or not SSL/TLS is used to connect to the SMTP server.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"host": {
+			Description:      "The host name of the SMTP server.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"id": getIDSchema(),
+		"password": {
+			Description: "The password used to authenticate with the SMTP server.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"port": {
+			Default:          25,
+			Description:      "The port of the SMTP server.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+		},
+		"send_email_from": {
+			Description:      "The address from which emails are sent.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"timeout": {
+			Default:          12000,
+			Description:      "The timeout (in milliseconds) of requests to the SMTP server.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"username": {
+			Description: "The username used to authenticate with the SMTP server.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setSMTPConfiguration(ctx context.Context, d *schema.ResourceData, configuration *smtpConfiguration) error {
+	d.Set("enable_ssl", configuration.EnableSsl)
+	d.Set("host", configuration.SmtpHost)
+	d.Set("port", configuration.SmtpPort)
+	d.Set("send_email_from", configuration.SendEmailFrom)
+	d.Set("timeout", configuration.Timeout)
+	d.Set("username", configuration.SmtpLogin)
+
+	d.SetId(smtpConfigurationID)
+
+	return nil
+}
+
+func getSMTPConfiguration(client *octopusdeploy.Client) (*smtpConfiguration, error) {
+	configuration := &smtpConfiguration{}
+	if err := apiGet(client.SMTPConfiguration.Sling, client.SMTPConfiguration.BasePath, configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+func updateSMTPConfiguration(client *octopusdeploy.Client, configuration *smtpConfiguration) (*smtpConfiguration, error) {
+	updatedConfiguration := &smtpConfiguration{}
+	if err := apiUpdate(client.SMTPConfiguration.Sling, client.SMTPConfiguration.BasePath, configuration, updatedConfiguration); err != nil {
+		return nil, err
+	}
+
+	return updatedConfiguration, nil
+}
@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandSSHConnectionWorker(d *schema.ResourceData) *octopusdeploy.Worker {
+	host := d.Get("host").(string)
+	port := d.Get("port").(int)
+
+	endpoint := expandSSHConnection(map[string]interface{}{
+		"account_id":            d.Get("account_id"),
+		"dot_net_core_platform": d.Get("dot_net_core_platform"),
+		"fingerprint":           d.Get("fingerprint"),
+		"host":                  host,
+		"id":                    "",
+		"port":                  port,
+		"proxy_id":              d.Get("proxy_id"),
+		"uri":                   fmt.Sprintf("ssh://%s:%d/", host, port),
+	})
+
+	return expandWorker(d, endpoint)
+}
+
+func getSSHConnectionWorkerSchema() map[string]*schema.Schema {
+	sshConnectionWorkerSchema := getTypedWorkerSchema()
+
+	sshConnectionWorkerSchema["account_id"] = &schema.Schema{
+		Description: "The ID of the account used to authenticate with this worker.",
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+
+	sshConnectionWorkerSchema["dot_net_core_platform"] = &schema.Schema{
+		Computed:    true,
+		Description: "The .NET Core platform of this worker. Leave empty to use Mono.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	sshConnectionWorkerSchema["fingerprint"] = &schema.Schema{
+		Description:      "The host fingerprint of this worker.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+	}
+
+	sshConnectionWorkerSchema["host"] = &schema.Schema{
+		Description:      "The host name or IP address of this worker.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+	}
+
+	sshConnectionWorkerSchema["port"] = &schema.Schema{
+		Default:          22,
+		Description:      "The SSH port of this worker.",
+		Optional:         true,
+		Type:             schema.TypeInt,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+	}
+
+	sshConnectionWorkerSchema["proxy_id"] = &schema.Schema{
+		Description: "The proxy ID that is associated with this worker.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return sshConnectionWorkerSchema
+}
+
+func setSSHConnectionWorker(ctx context.Context, d *schema.ResourceData, worker *octopusdeploy.Worker) error {
+	endpointResource, err := octopusdeploy.ToEndpointResource(worker.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	d.Set("account_id", endpointResource.AccountID)
+	d.Set("dot_net_core_platform", endpointResource.DotNetCorePlatform)
+	d.Set("fingerprint", endpointResource.Fingerprint)
+	d.Set("host", endpointResource.Host)
+	d.Set("port", endpointResource.Port)
+	d.Set("proxy_id", endpointResource.ProxyID)
+
+	return setWorker(ctx, d, worker)
+}
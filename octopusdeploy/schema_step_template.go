@@ -0,0 +1,223 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandStepTemplate(d *schema.ResourceData) *octopusdeploy.ActionTemplate {
+	name := d.Get("name").(string)
+	actionType := d.Get("action_type").(string)
+
+	stepTemplate := octopusdeploy.NewActionTemplate(name, actionType)
+	stepTemplate.ID = d.Id()
+
+	if v, ok := d.GetOk("community_action_template_id"); ok {
+		stepTemplate.CommunityActionTemplateID = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		stepTemplate.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("package"); ok {
+		for _, tfPackage := range v.([]interface{}) {
+			stepTemplate.Packages = append(stepTemplate.Packages, expandPackageReference(tfPackage.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := d.GetOk("parameter"); ok {
+		stepTemplate.Parameters = expandActionTemplateParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("properties"); ok {
+		stepTemplate.Properties = expandProperties(v)
+	}
+
+	if v, ok := d.GetOk("script_body"); ok {
+		stepTemplate.Properties["Octopus.Action.Script.ScriptBody"] = octopusdeploy.NewPropertyValue(v.(string), false)
+		stepTemplate.Properties["Octopus.Action.Script.ScriptSource"] = octopusdeploy.NewPropertyValue("Inline", false)
+		stepTemplate.Properties["Octopus.Action.Script.Syntax"] = octopusdeploy.NewPropertyValue(d.Get("script_syntax").(string), false)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		stepTemplate.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		stepTemplate.Version = int32(v.(int))
+	}
+
+	return stepTemplate
+}
+
+func flattenStepTemplate(stepTemplate *octopusdeploy.ActionTemplate) map[string]interface{} {
+	if stepTemplate == nil {
+		return nil
+	}
+
+	flattenedStepTemplate := map[string]interface{}{
+		"action_type":                  stepTemplate.ActionType,
+		"community_action_template_id": stepTemplate.CommunityActionTemplateID,
+		"description":                  stepTemplate.Description,
+		"id":                           stepTemplate.GetID(),
+		"name":                         stepTemplate.Name,
+		"package":                      flattenStepTemplatePackages(stepTemplate.Packages),
+		"parameter":                    flattenActionTemplateParameters(stepTemplate.Parameters),
+		"properties":                   flattenStepTemplateProperties(stepTemplate.Properties),
+		"space_id":                     stepTemplate.SpaceID,
+		"version":                      stepTemplate.Version,
+	}
+
+	if v, ok := stepTemplate.Properties["Octopus.Action.Script.ScriptBody"]; ok {
+		flattenedStepTemplate["script_body"] = v.Value
+	}
+
+	if v, ok := stepTemplate.Properties["Octopus.Action.Script.Syntax"]; ok {
+		flattenedStepTemplate["script_syntax"] = v.Value
+	}
+
+	return flattenedStepTemplate
+}
+
+func flattenStepTemplatePackages(packageReferences []octopusdeploy.PackageReference) []interface{} {
+	flattenedPackageReferences := []interface{}{}
+	for _, packageReference := range packageReferences {
+		flattenedPackageReferences = append(flattenedPackageReferences, flattenPackageReference(packageReference))
+	}
+	return flattenedPackageReferences
+}
+
+// flattenStepTemplateProperties flattens the properties of a step template
+// without the inline script properties, which are represented by the
+// script_body and script_syntax attributes instead.
+func flattenStepTemplateProperties(properties map[string]octopusdeploy.PropertyValue) map[string]interface{} {
+	flattenedProperties := flattenProperties(properties)
+	if v, ok := properties["Octopus.Action.Script.ScriptSource"]; !ok || v.Value != "Inline" {
+		return flattenedProperties
+	}
+
+	delete(flattenedProperties, "Octopus.Action.Script.ScriptBody")
+	delete(flattenedProperties, "Octopus.Action.Script.ScriptSource")
+	delete(flattenedProperties, "Octopus.Action.Script.Syntax")
+
+	return flattenedProperties
+}
+
+func getStepTemplateDataSchema() map[string]*schema.Schema {
+	dataSchema := getStepTemplateSchema()
+	setDataSchema(&dataSchema)
+
+	return map[string]*schema.Schema{
+		"id":           getDataSchemaID(),
+		"ids":          getQueryIDs(),
+		"partial_name": getQueryPartialName(),
+		"skip":         getQuerySkip(),
+		"step_templates": {
+			Computed:    true,
+			Description: "A list of step templates that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"take": getQueryTake(),
+	}
+}
+
+func getStepTemplateSchema() map[string]*schema.Schema {
+	packageSchema := getPackageSchema(false)
+	packageSchema.Computed = false
+	packageSchema.Description = "The packages referenced by this step template. The primary package of the step template has an empty name."
+
+	return map[string]*schema.Schema{
+		"action_type": {
+			Description:      "The type of the action that is created from this step template (e.g. `Octopus.Script`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"community_action_template_id": {
+			Description: "The ID of the community step template from which this step template was installed.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"description": getDescriptionSchema(),
+		"id":          getIDSchema(),
+		"name":        getNameSchema(true),
+		"package":     packageSchema,
+		"parameter": {
+			Description: "The parameters of this step template. Parameters are presented as inputs to the actions that use this step template.",
+			Elem:        &schema.Resource{Schema: getActionTemplateParameterSchema()},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"properties": {
+			Description: "The properties of the action that is created from this step template.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeMap,
+		},
+		"script_body": {
+			Description: "The inline script of this step template.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"script_syntax": {
+			Default:     "PowerShell",
+			Description: "The syntax of the inline script of this step template. Valid syntaxes are `Bash`, `CSharp`, `FSharp`, `PowerShell`, or `Python`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"Bash",
+				"CSharp",
+				"FSharp",
+				"PowerShell",
+				"Python",
+			}, false)),
+		},
+		"space_id": getSpaceIDSchema(),
+		"version": {
+			Computed:    true,
+			Description: "The current version of this step template. The version is incremented by Octopus Deploy whenever the step template is updated, and can be used to pin the `action_template` of a deployment action.",
+			Type:        schema.TypeInt,
+		},
+	}
+}
+
+func setStepTemplate(ctx context.Context, d *schema.ResourceData, stepTemplate *octopusdeploy.ActionTemplate) error {
+	flattenedStepTemplate := flattenStepTemplate(stepTemplate)
+
+	d.Set("action_type", stepTemplate.ActionType)
+	d.Set("community_action_template_id", stepTemplate.CommunityActionTemplateID)
+	d.Set("description", stepTemplate.Description)
+	d.Set("name", stepTemplate.Name)
+	d.Set("space_id", stepTemplate.SpaceID)
+	d.Set("version", stepTemplate.Version)
+
+	if v, ok := flattenedStepTemplate["script_body"]; ok {
+		d.Set("script_body", v)
+		d.Set("script_syntax", flattenedStepTemplate["script_syntax"])
+	} else {
+		d.Set("script_body", nil)
+	}
+
+	if err := d.Set("package", flattenedStepTemplate["package"]); err != nil {
+		return fmt.Errorf("error setting package: %s", err)
+	}
+
+	if err := d.Set("parameter", flattenedStepTemplate["parameter"]); err != nil {
+		return fmt.Errorf("error setting parameter: %s", err)
+	}
+
+	if err := d.Set("properties", flattenedStepTemplate["properties"]); err != nil {
+		return fmt.Errorf("error setting properties: %s", err)
+	}
+
+	d.SetId(stepTemplate.GetID())
+
+	return nil
+}
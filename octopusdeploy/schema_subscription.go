@@ -0,0 +1,310 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// eventSubscription represents an event subscription in Octopus Deploy. The client
+// does not support subscriptions beyond deleting them.
+type eventSubscription struct {
+	EventNotificationSubscription *eventNotificationSubscription `json:"EventNotificationSubscription"`
+	ID                            string                         `json:"Id,omitempty"`
+	IsDisabled                    bool                           `json:"IsDisabled"`
+	Name                          string                         `json:"Name"`
+	SpaceID                       string                         `json:"SpaceId,omitempty"`
+	Type                          string                         `json:"Type"`
+}
+
+type eventNotificationSubscription struct {
+	EmailDigestLastProcessed            *string                              `json:"EmailDigestLastProcessed,omitempty"`
+	EmailDigestLastProcessedEventAutoID *int64                               `json:"EmailDigestLastProcessedEventAutoId,omitempty"`
+	EmailFrequencyPeriod                string                               `json:"EmailFrequencyPeriod,omitempty"`
+	EmailPriority                       string                               `json:"EmailPriority,omitempty"`
+	EmailShowDatesInTimeZoneID          string                               `json:"EmailShowDatesInTimeZoneId,omitempty"`
+	EmailTeams                          []string                             `json:"EmailTeams"`
+	Filter                              *eventNotificationSubscriptionFilter `json:"Filter"`
+	WebhookHeaderKey                    string                               `json:"WebhookHeaderKey,omitempty"`
+	WebhookHeaderValue                  string                               `json:"WebhookHeaderValue,omitempty"`
+	WebhookLastProcessed                *string                              `json:"WebhookLastProcessed,omitempty"`
+	WebhookLastProcessedEventAutoID     *int64                               `json:"WebhookLastProcessedEventAutoId,omitempty"`
+	WebhookTeams                        []string                             `json:"WebhookTeams"`
+	WebhookTimeout                      string                               `json:"WebhookTimeout,omitempty"`
+	WebhookURI                          string                               `json:"WebhookURI,omitempty"`
+}
+
+type eventNotificationSubscriptionFilter struct {
+	DocumentTypes   []string `json:"DocumentTypes"`
+	Environments    []string `json:"Environments"`
+	EventAgents     []string `json:"EventAgents"`
+	EventCategories []string `json:"EventCategories"`
+	EventGroups     []string `json:"EventGroups"`
+	ProjectGroups   []string `json:"ProjectGroups"`
+	Projects        []string `json:"Projects"`
+	Tags            []string `json:"Tags"`
+	Tenants         []string `json:"Tenants"`
+	Users           []string `json:"Users"`
+}
+
+func expandSubscription(d *schema.ResourceData) (*eventSubscription, error) {
+	filter := &eventNotificationSubscriptionFilter{
+		DocumentTypes:   []string{},
+		Environments:    expandArray(d.Get("environment_ids").([]interface{})),
+		EventAgents:     []string{},
+		EventCategories: expandArray(d.Get("event_categories").([]interface{})),
+		EventGroups:     expandArray(d.Get("event_groups").([]interface{})),
+		ProjectGroups:   []string{},
+		Projects:        expandArray(d.Get("project_ids").([]interface{})),
+		Tags:            []string{},
+		Tenants:         expandArray(d.Get("tenant_ids").([]interface{})),
+		Users:           []string{},
+	}
+
+	notification := &eventNotificationSubscription{
+		EmailTeams:   []string{},
+		Filter:       filter,
+		WebhookTeams: []string{},
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		email := v.([]interface{})[0].(map[string]interface{})
+
+		digestFrequency, err := time.ParseDuration(email["digest_frequency"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		notification.EmailFrequencyPeriod = formatTimeSpan(digestFrequency)
+		notification.EmailPriority = email["priority"].(string)
+		notification.EmailShowDatesInTimeZoneID = email["timezone"].(string)
+		notification.EmailTeams = expandArray(email["team_ids"].([]interface{}))
+	}
+
+	if v, ok := d.GetOk("webhook"); ok {
+		webhook := v.([]interface{})[0].(map[string]interface{})
+
+		timeout, err := time.ParseDuration(webhook["timeout"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		notification.WebhookHeaderKey = webhook["header_key"].(string)
+		notification.WebhookHeaderValue = webhook["header_value"].(string)
+		notification.WebhookTeams = expandArray(webhook["team_ids"].([]interface{}))
+		notification.WebhookTimeout = formatTimeSpan(timeout)
+		notification.WebhookURI = webhook["uri"].(string)
+	}
+
+	return &eventSubscription{
+		EventNotificationSubscription: notification,
+		ID:                            d.Id(),
+		IsDisabled:                    d.Get("is_disabled").(bool),
+		Name:                          d.Get("name").(string),
+		SpaceID:                       d.Get("space_id").(string),
+		Type:                          "Event",
+	}, nil
+}
+
+func flattenSubscriptionEmail(notification *eventNotificationSubscription) ([]interface{}, error) {
+	if len(notification.EmailTeams) == 0 {
+		return nil, nil
+	}
+
+	digestFrequency, err := parseTimeSpan(notification.EmailFrequencyPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{map[string]interface{}{
+		"digest_frequency": digestFrequency.String(),
+		"priority":         notification.EmailPriority,
+		"team_ids":         notification.EmailTeams,
+		"timezone":         notification.EmailShowDatesInTimeZoneID,
+	}}, nil
+}
+
+func flattenSubscriptionWebhook(notification *eventNotificationSubscription) ([]interface{}, error) {
+	if len(notification.WebhookURI) == 0 {
+		return nil, nil
+	}
+
+	timeout, err := parseTimeSpan(notification.WebhookTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{map[string]interface{}{
+		"header_key":   notification.WebhookHeaderKey,
+		"header_value": notification.WebhookHeaderValue,
+		"team_ids":     notification.WebhookTeams,
+		"timeout":      timeout.String(),
+		"uri":          notification.WebhookURI,
+	}}, nil
+}
+
+func getSubscriptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"email": {
+			AtLeastOneOf: []string{"email", "webhook"},
+			Description:  "Sends digests of the matching events to the members of teams by email.",
+			Elem:         &schema.Resource{Schema: getSubscriptionEmailSchema()},
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"environment_ids":  getSubscriptionFilterSchema("A list of environment IDs to which matching events are restricted."),
+		"event_categories": getSubscriptionFilterSchema("A list of event categories (e.g. `DeploymentFailed`) to which matching events are restricted."),
+		"event_groups":     getSubscriptionFilterSchema("A list of event groups (e.g. `DeploymentCritical`) to which matching events are restricted."),
+		"id":               getIDSchema(),
+		"is_disabled": {
+			Description: "Indicates whether or not this subscription is disabled.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name":        getNameSchema(true),
+		"project_ids": getSubscriptionFilterSchema("A list of project IDs to which matching events are restricted."),
+		"space_id":    getSpaceIDSchema(),
+		"tenant_ids":  getSubscriptionFilterSchema("A list of tenant IDs to which matching events are restricted."),
+		"webhook": {
+			AtLeastOneOf: []string{"email", "webhook"},
+			Description:  "Sends each matching event to a webhook.",
+			Elem:         &schema.Resource{Schema: getSubscriptionWebhookSchema()},
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+	}
+}
+
+func getSubscriptionEmailSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"digest_frequency": {
+			Default:          "1h",
+			Description:      "The frequency (e.g. `30m` or `1h`) at which digests are sent.",
+			DiffSuppressFunc: suppressEquivalentDurationDiffs,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateDuration,
+		},
+		"priority": {
+			Default:          "Normal",
+			Description:      "The priority of the emails. Valid priorities are `Low`, `Normal`, or `High`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"High", "Low", "Normal"}, false)),
+		},
+		"team_ids": {
+			Description: "A list of team IDs whose members receive the digests.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    1,
+			Required:    true,
+			Type:        schema.TypeList,
+		},
+		"timezone": {
+			Default:          "UTC",
+			Description:      "The time zone in which the dates of the digests are shown. Both IANA (e.g. `Australia/Brisbane`) and Windows (e.g. `E. Australia Standard Time`) time zone identifiers are supported.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateTimeZone,
+		},
+	}
+}
+
+func getSubscriptionFilterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+}
+
+func getSubscriptionWebhookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"header_key": {
+			Description: "The name of a header that is sent with each request.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"header_value": {
+			Description: "The value of the header that is sent with each request.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"team_ids": {
+			Description: "A list of team IDs whose members are included in the payload of each request.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"timeout": {
+			Default:          "10s",
+			Description:      "The timeout (e.g. `10s`) of each request.",
+			DiffSuppressFunc: suppressEquivalentDurationDiffs,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateDuration,
+		},
+		"uri": {
+			Description:      "The URI to which matching events are sent.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		},
+	}
+}
+
+func setSubscription(ctx context.Context, d *schema.ResourceData, subscription *eventSubscription) error {
+	notification := subscription.EventNotificationSubscription
+
+	d.Set("is_disabled", subscription.IsDisabled)
+	d.Set("name", subscription.Name)
+	d.Set("space_id", subscription.SpaceID)
+
+	if err := d.Set("environment_ids", notification.Filter.Environments); err != nil {
+		return fmt.Errorf("error setting environment_ids: %s", err)
+	}
+
+	if err := d.Set("event_categories", notification.Filter.EventCategories); err != nil {
+		return fmt.Errorf("error setting event_categories: %s", err)
+	}
+
+	if err := d.Set("event_groups", notification.Filter.EventGroups); err != nil {
+		return fmt.Errorf("error setting event_groups: %s", err)
+	}
+
+	if err := d.Set("project_ids", notification.Filter.Projects); err != nil {
+		return fmt.Errorf("error setting project_ids: %s", err)
+	}
+
+	if err := d.Set("tenant_ids", notification.Filter.Tenants); err != nil {
+		return fmt.Errorf("error setting tenant_ids: %s", err)
+	}
+
+	email, err := flattenSubscriptionEmail(notification)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("email", email); err != nil {
+		return fmt.Errorf("error setting email: %s", err)
+	}
+
+	webhook, err := flattenSubscriptionWebhook(notification)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("webhook", webhook); err != nil {
+		return fmt.Errorf("error setting webhook: %s", err)
+	}
+
+	d.SetId(subscription.ID)
+
+	return nil
+}
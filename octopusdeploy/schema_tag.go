@@ -1,6 +1,10 @@
 package octopusdeploy
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,3 +56,76 @@ func getTagsSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// expandTagResource converts the attributes of a tag resource into a tag of a
+// tag set.
+func expandTagResource(d *schema.ResourceData, id string) octopusdeploy.Tag {
+	return octopusdeploy.Tag{
+		Color:       d.Get("color").(string),
+		Description: d.Get("description").(string),
+		ID:          id,
+		Name:        d.Get("name").(string),
+		SortOrder:   d.Get("sort_order").(int),
+	}
+}
+
+func getTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"canonical_tag_name": {
+			Computed:    true,
+			Description: "The canonical name of this tag (e.g. `TagSet/Tag`) that is used to reference it in `tenant_tags`.",
+			Type:        schema.TypeString,
+		},
+		"color": {
+			Description: "The color of this tag (e.g. `#6e6e6e`).",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"description": getDescriptionSchema(),
+		"id":          getIDSchema(),
+		"name":        getNameSchema(true),
+		"sort_order":  getSortOrderSchema(),
+		"tag_set_id": {
+			Description: "The ID of the tag set to which this tag belongs.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setTag(ctx context.Context, d *schema.ResourceData, tagSetID string, tag *octopusdeploy.Tag) error {
+	d.Set("canonical_tag_name", tag.CanonicalTagName)
+	d.Set("color", tag.Color)
+	d.Set("description", tag.Description)
+	d.Set("name", tag.Name)
+	d.Set("sort_order", tag.SortOrder)
+	d.Set("tag_set_id", tagSetID)
+
+	d.SetId(tagSetID + ":" + tag.ID)
+
+	return nil
+}
+
+// parseTagID returns the tag set ID and the tag ID of a tag resource ID (e.g.
+// TagSets-1:TagSets-1-1).
+func parseTagID(id string) (string, string, error) {
+	ids := strings.Split(id, ":")
+	if len(ids) != 2 || len(ids[0]) == 0 || len(ids[1]) == 0 {
+		return "", "", fmt.Errorf("the ID of a tag must be in the form of TagSetID:TagID (e.g. TagSets-1:TagSets-1-1)")
+	}
+
+	return ids[0], ids[1], nil
+}
+
+// findTag returns the index of the tag of a tag set that matches the
+// predicate or -1 if there is none.
+func findTag(tags []octopusdeploy.Tag, predicate func(tag octopusdeploy.Tag) bool) int {
+	for i, tag := range tags {
+		if predicate(tag) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -3,11 +3,16 @@ package octopusdeploy
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// the tags of a tag set are stored in the tag set; this mutex serializes the
+// read-modify-write cycles of the resources that update them
+var tagSetMutex = &sync.Mutex{}
+
 func expandTagSet(d *schema.ResourceData) *octopusdeploy.TagSet {
 	name := d.Get("name").(string)
 
@@ -55,6 +60,7 @@ func flattenTagSet(tagSet *octopusdeploy.TagSet) map[string]interface{} {
 func getTagSetDataSchema() map[string]*schema.Schema {
 	dataSchema := getTagSetSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "ignore_undeclared_tags")
 
 	return map[string]*schema.Schema{
 		"ids":          getQueryIDs(),
@@ -75,9 +81,15 @@ func getTagSetSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"description": getDescriptionSchema(),
 		"id":          getIDSchema(),
-		"name":        getNameSchema(true),
-		"sort_order":  getSortOrderSchema(),
-		"space_id":    getSpaceIDSchema(),
+		"ignore_undeclared_tags": {
+			Default:     false,
+			Description: "Indicates whether or not the tags that are not declared by this resource are ignored so that they can be managed by `octopusdeploy_tag` resources.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name":       getNameSchema(true),
+		"sort_order": getSortOrderSchema(),
+		"space_id":   getSpaceIDSchema(),
 		"tag": {
 			Description: "A list of tags.",
 			Elem:        &schema.Resource{Schema: getTagsSchema()},
@@ -94,9 +106,62 @@ func setTagSet(ctx context.Context, d *schema.ResourceData, tagSet *octopusdeplo
 	d.Set("sort_order", tagSet.SortOrder)
 	d.Set("space_id", tagSet.SpaceID)
 
-	if err := d.Set("tag", flattenTags(tagSet.Tags)); err != nil {
+	tags := tagSet.Tags
+	if d.Get("ignore_undeclared_tags").(bool) {
+		tags = getDeclaredTags(tagSet.Tags, d.Get("tag").([]interface{}))
+	}
+
+	if err := d.Set("tag", flattenTags(tags)); err != nil {
 		return fmt.Errorf("error setting tag: %s", err)
 	}
 
 	return nil
 }
+
+// getDeclaredTags returns the tags that match a declared tag by ID or by name.
+func getDeclaredTags(tags []octopusdeploy.Tag, declaredTags []interface{}) []octopusdeploy.Tag {
+	filteredTags := []octopusdeploy.Tag{}
+	for _, tag := range tags {
+		if isDeclaredTag(tag, declaredTags) {
+			filteredTags = append(filteredTags, tag)
+		}
+	}
+
+	return filteredTags
+}
+
+// getUndeclaredTags returns the current tags of a tag set that are neither
+// declared nor were previously declared (that is, the tags that are managed
+// elsewhere).
+func getUndeclaredTags(currentTags []octopusdeploy.Tag, declaredTags []interface{}, previouslyDeclaredTags []interface{}) []octopusdeploy.Tag {
+	previouslyDeclaredIDs := map[string]bool{}
+	for _, v := range previouslyDeclaredTags {
+		if id := v.(map[string]interface{})["id"].(string); len(id) > 0 {
+			previouslyDeclaredIDs[id] = true
+		}
+	}
+
+	undeclaredTags := []octopusdeploy.Tag{}
+	for _, tag := range currentTags {
+		if !previouslyDeclaredIDs[tag.ID] && !isDeclaredTag(tag, declaredTags) {
+			undeclaredTags = append(undeclaredTags, tag)
+		}
+	}
+
+	return undeclaredTags
+}
+
+func isDeclaredTag(tag octopusdeploy.Tag, declaredTags []interface{}) bool {
+	for _, v := range declaredTags {
+		declaredTag := v.(map[string]interface{})
+		if id := declaredTag["id"].(string); len(id) > 0 && id == tag.ID {
+			return true
+		}
+
+		if declaredTag["name"].(string) == tag.Name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUndeclaredTags(t *testing.T) {
+	currentTags := []octopusdeploy.Tag{
+		{ID: "TagSets-1-1", Name: "Alpha"},
+		{ID: "TagSets-1-2", Name: "Beta"},
+		{ID: "TagSets-1-3", Name: "Gamma"},
+		{ID: "TagSets-1-4", Name: "Delta"},
+	}
+
+	previouslyDeclaredTags := []interface{}{
+		map[string]interface{}{"id": "TagSets-1-1", "name": "Alpha"},
+		map[string]interface{}{"id": "TagSets-1-2", "name": "Beta"},
+	}
+
+	// Beta is no longer declared and Gamma is now declared (by name)
+	declaredTags := []interface{}{
+		map[string]interface{}{"id": "TagSets-1-1", "name": "Alpha"},
+		map[string]interface{}{"id": "", "name": "Gamma"},
+	}
+
+	undeclaredTags := getUndeclaredTags(currentTags, declaredTags, previouslyDeclaredTags)
+	require.Equal(t, []octopusdeploy.Tag{{ID: "TagSets-1-4", Name: "Delta"}}, undeclaredTags)
+
+	require.Equal(t, currentTags[:1], getDeclaredTags(currentTags, previouslyDeclaredTags[:1]))
+}
@@ -71,6 +71,7 @@ func flattenTeam(team *octopusdeploy.Team) map[string]interface{} {
 func getTeamDataSchema() map[string]*schema.Schema {
 	dataSchema := getTeamSchema()
 	setDataSchema(&dataSchema)
+	dataSchema["users"].Description = "A list of user IDs designated to be members of this team."
 
 	return map[string]*schema.Schema{
 		"id":             getDataSchemaID(),
@@ -141,7 +142,7 @@ func getTeamSchema() map[string]*schema.Schema {
 		},
 		"users": {
 			Computed:    true,
-			Description: "A list of user IDs designated to be members of this team.",
+			Description: "A list of user IDs designated to be members of this team. If not specified, the members of this team are left untouched so that they can be managed through `octopusdeploy_team_membership`.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
 			Type:        schema.TypeList,
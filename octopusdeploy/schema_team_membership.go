@@ -0,0 +1,130 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// the members of a team are stored in the team; this mutex serializes the
+// read-modify-write cycles of the resources that update them (concurrent
+// modifications by other clients are detected through the version of the team)
+var teamMutex = &sync.Mutex{}
+
+// versionedTeam represents a team along with its version, which is used to
+// detect concurrent modifications of the team. The client does not support
+// the latter.
+type versionedTeam struct {
+	Version *int `json:"Version,omitempty"`
+
+	*octopusdeploy.Team
+}
+
+func getTeamMembershipSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"team_id": {
+			Description:      "The ID of the team.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"user_id": {
+			Description:      "The ID of the user that is a member of the team.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+	}
+}
+
+func setTeamMembership(ctx context.Context, d *schema.ResourceData, teamID string, userID string) error {
+	d.Set("team_id", teamID)
+	d.Set("user_id", userID)
+
+	d.SetId(teamID + ":" + userID)
+
+	return nil
+}
+
+// parseTeamMembershipID returns the team ID and the user ID of a team
+// membership ID (e.g. Teams-1:Users-1).
+func parseTeamMembershipID(id string) (string, string, error) {
+	ids := strings.Split(id, ":")
+	if len(ids) != 2 || len(ids[0]) == 0 || len(ids[1]) == 0 {
+		return "", "", fmt.Errorf("the ID of a team membership must be in the form of TeamID:UserID (e.g. Teams-1:Users-1)")
+	}
+
+	return ids[0], ids[1], nil
+}
+
+func getVersionedTeam(client *octopusdeploy.Client, id string) (*versionedTeam, error) {
+	team := &versionedTeam{Team: &octopusdeploy.Team{}}
+	if err := apiGet(client.Teams.Sling, client.Teams.BasePath+"/"+id, team); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+func isTeamMember(team *octopusdeploy.Team, userID string) bool {
+	for _, memberUserID := range team.MemberUserIDs {
+		if memberUserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateVersionedTeam reads a team, modifies it and writes it back with the
+// version that was read. If the team was modified concurrently, it is read
+// again and the modification is re-applied. The modification returns false if
+// the team does not need to be updated. An error is returned if the server
+// does not version teams since concurrent modifications would then be lost.
+func updateVersionedTeam(ctx context.Context, client *octopusdeploy.Client, teamID string, timeout time.Duration, modify func(team *octopusdeploy.Team) bool) (*versionedTeam, error) {
+	var result *versionedTeam
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		team, err := getVersionedTeam(client, teamID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if team.Version == nil {
+			return resource.NonRetryableError(fmt.Errorf("the Octopus server did not return the version of team (%s); concurrent modifications of its members cannot be detected", teamID))
+		}
+
+		if !modify(team.Team) {
+			result = team
+			return nil
+		}
+
+		updatedTeam := &versionedTeam{Team: &octopusdeploy.Team{}}
+		if err := apiUpdateVersioned(client.Teams.Sling, client.Teams.BasePath+"/"+teamID, team, updatedTeam); err != nil {
+			if err == errConcurrentModification {
+				log.Printf("[INFO] team (%s) was modified concurrently; retrying", teamID)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if updatedTeam.Version == nil || *updatedTeam.Version == *team.Version {
+			return resource.NonRetryableError(fmt.Errorf("the Octopus server did not update the version of team (%s); concurrent modifications of its members cannot be detected", teamID))
+		}
+
+		result = updatedTeam
+		return nil
+	})
+
+	return result, err
+}
@@ -22,7 +22,7 @@ func expandTenant(d *schema.ResourceData) *octopusdeploy.Tenant {
 		tenant.Description = v.(string)
 	}
 
-	if v, ok := d.GetOk("project_environment"); ok {
+	if v, ok := d.GetOk("project_environment"); ok && !d.Get("ignore_project_environments").(bool) {
 		tenant.ProjectEnvironments = expandProjectEnvironments(v)
 	}
 
@@ -56,6 +56,7 @@ func flattenTenant(tenant *octopusdeploy.Tenant) map[string]interface{} {
 func getTenantDataSchema() map[string]*schema.Schema {
 	dataSchema := getTenantSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "ignore_project_environments")
 
 	return map[string]*schema.Schema{
 		"cloned_from_tenant_id": getQueryClonedFromTenantID(),
@@ -87,9 +88,16 @@ func getTenantSchema() map[string]*schema.Schema {
 		},
 		"description": getDescriptionSchema(),
 		"id":          getIDSchema(),
-		"name":        getNameSchema(true),
+		"ignore_project_environments": {
+			Default:     false,
+			Description: "Indicates whether or not the projects to which this tenant is connected are ignored so that they can be managed by `octopusdeploy_tenant_project` resources.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": getNameSchema(true),
 		"project_environment": {
-			Optional: true,
+			ConflictsWith: []string{"ignore_project_environments"},
+			Optional:      true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"environments": {
@@ -118,8 +126,11 @@ func setTenant(ctx context.Context, d *schema.ResourceData, tenant *octopusdeplo
 	d.Set("id", tenant.GetID())
 	d.Set("name", tenant.Name)
 
-	if err := d.Set("project_environment", flattenProjectEnvironments(tenant.ProjectEnvironments)); err != nil {
-		return fmt.Errorf("error setting project_environment: %s", err)
+	// the connections to projects are managed elsewhere when they are ignored
+	if !d.Get("ignore_project_environments").(bool) {
+		if err := d.Set("project_environment", flattenProjectEnvironments(tenant.ProjectEnvironments)); err != nil {
+			return fmt.Errorf("error setting project_environment: %s", err)
+		}
 	}
 
 	d.Set("space_id", tenant.SpaceID)
@@ -0,0 +1,70 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// tenant common variables are identified by the IDs of the tenant, library
+// variable set and template (e.g. Tenants-1:LibraryVariableSets-1:<template-id>)
+func getTenantCommonVariableID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get("tenant_id").(string),
+		d.Get("library_variable_set_id").(string),
+		d.Get("template_id").(string),
+	}, ":")
+}
+
+func parseTenantCommonVariableID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("tenant common variable ID must be in the form of TenantID:LibraryVariableSetID:TemplateID (e.g. Tenants-123:LibraryVariableSets-123:0906031f-68ba-4a15-afaa-657c1564e07b)")
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func getTenantCommonVariableSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"library_variable_set_id": {
+			Description:      "The ID of the library variable set that defines the template.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"template_id": {
+			Description:      "The ID of the library variable set template for which the value is set.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"tenant_id": {
+			Description:      "The ID of the tenant.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"value": {
+			Description: "The value of the variable. Values of templates with a control type of `Sensitive` are stored as sensitive values.",
+			Required:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setTenantCommonVariable(ctx context.Context, d *schema.ResourceData, tenantID string, libraryVariableSetID string, templateID string, value string) {
+	d.Set("library_variable_set_id", libraryVariableSetID)
+	d.Set("template_id", templateID)
+	d.Set("tenant_id", tenantID)
+	d.Set("value", value)
+
+	d.SetId(getTenantCommonVariableID(d))
+}
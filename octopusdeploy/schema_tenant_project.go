@@ -0,0 +1,74 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// the connections of a tenant to projects are stored in the tenant; this mutex
+// serializes the read-modify-write cycles of the resources that update them
+var tenantProjectEnvironmentsMutex = &sync.Mutex{}
+
+func expandTenantProjectEnvironmentIDs(d *schema.ResourceData) []string {
+	environmentIDs := []string{}
+	for _, environmentID := range d.Get("environment_ids").(*schema.Set).List() {
+		environmentIDs = append(environmentIDs, environmentID.(string))
+	}
+
+	return environmentIDs
+}
+
+func getTenantProjectSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_ids": {
+			Description: "The IDs of the environments of the project to which the tenant is connected.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Type:        schema.TypeSet,
+		},
+		"id": getIDSchema(),
+		"project_id": {
+			Description:      "The ID of the project to which the tenant is connected.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"tenant_id": {
+			Description:      "The ID of the tenant.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+}
+
+func setTenantProject(ctx context.Context, d *schema.ResourceData, tenantID string, projectID string, environmentIDs []string) error {
+	d.Set("project_id", projectID)
+	d.Set("tenant_id", tenantID)
+
+	if err := d.Set("environment_ids", environmentIDs); err != nil {
+		return fmt.Errorf("error setting environment_ids: %s", err)
+	}
+
+	d.SetId(tenantID + ":" + projectID)
+
+	return nil
+}
+
+// parseTenantProjectID returns the tenant ID and the project ID of a tenant
+// project ID (e.g. Tenants-1:Projects-1).
+func parseTenantProjectID(id string) (string, string, error) {
+	ids := strings.Split(id, ":")
+	if len(ids) != 2 || len(ids[0]) == 0 || len(ids[1]) == 0 {
+		return "", "", fmt.Errorf("the ID of a tenant project must be in the form of TenantID:ProjectID (e.g. Tenants-1:Projects-1)")
+	}
+
+	return ids[0], ids[1], nil
+}
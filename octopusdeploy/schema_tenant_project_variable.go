@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// tenant project variables are identified by the IDs of the tenant, project,
+// environment and template (e.g.
+// Tenants-1:Projects-1:Environments-1:<template-id>)
+func getTenantProjectVariableID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get("tenant_id").(string),
+		d.Get("project_id").(string),
+		d.Get("environment_id").(string),
+		d.Get("template_id").(string),
+	}, ":")
+}
+
+func parseTenantProjectVariableID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("tenant project variable ID must be in the form of TenantID:ProjectID:EnvironmentID:TemplateID (e.g. Tenants-123:Projects-123:Environments-123:0906031f-68ba-4a15-afaa-657c1564e07b)")
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func getTenantProjectVariableSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_id": {
+			Description:      "The ID of the environment of the project to which the tenant is connected.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"id": getIDSchema(),
+		"project_id": {
+			Description:      "The ID of the project that defines the template.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"template_id": {
+			Description:      "The ID of the project template for which the value is set.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"tenant_id": {
+			Description:      "The ID of the tenant.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"value": {
+			Description: "The value of the variable. Values of templates with a control type of `Sensitive` are stored as sensitive values.",
+			Required:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setTenantProjectVariable(ctx context.Context, d *schema.ResourceData, tenantID string, projectID string, environmentID string, templateID string, value string) {
+	d.Set("environment_id", environmentID)
+	d.Set("project_id", projectID)
+	d.Set("template_id", templateID)
+	d.Set("tenant_id", tenantID)
+	d.Set("value", value)
+
+	d.SetId(getTenantProjectVariableID(d))
+}
@@ -0,0 +1,237 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// the variables of a tenant are stored in a single document; this mutex
+// serializes the read-modify-write cycles of the resources that manage
+// individual values of the document
+var tenantVariablesMutex = &sync.Mutex{}
+
+// tenantVariables represents the variables of a tenant. The client does not
+// support tenant variables. Values are kept as raw JSON so that the values
+// which are not managed by a resource are sent back unchanged (including
+// sensitive values, which are never returned by the server).
+type tenantVariables struct {
+	LibraryVariables map[string]*tenantLibraryVariables `json:"LibraryVariables"`
+	ProjectVariables map[string]*tenantProjectVariables `json:"ProjectVariables"`
+	SpaceID          string                             `json:"SpaceId,omitempty"`
+	TenantID         string                             `json:"TenantId"`
+	TenantName       string                             `json:"TenantName,omitempty"`
+}
+
+type tenantLibraryVariables struct {
+	LibraryVariableSetID   string                     `json:"LibraryVariableSetId"`
+	LibraryVariableSetName string                     `json:"LibraryVariableSetName,omitempty"`
+	Templates              []*tenantVariableTemplate  `json:"Templates"`
+	Variables              map[string]json.RawMessage `json:"Variables"`
+}
+
+type tenantProjectVariables struct {
+	ProjectID   string                                `json:"ProjectId"`
+	ProjectName string                                `json:"ProjectName,omitempty"`
+	Templates   []*tenantVariableTemplate             `json:"Templates"`
+	Variables   map[string]map[string]json.RawMessage `json:"Variables"`
+}
+
+type tenantVariableTemplate struct {
+	DefaultValue    json.RawMessage   `json:"DefaultValue,omitempty"`
+	DisplaySettings map[string]string `json:"DisplaySettings,omitempty"`
+	HelpText        string            `json:"HelpText,omitempty"`
+	ID              string            `json:"Id"`
+	Label           string            `json:"Label,omitempty"`
+	Name            string            `json:"Name"`
+}
+
+func (t *tenantVariableTemplate) isSensitive() bool {
+	return t.DisplaySettings["Octopus.ControlType"] == "Sensitive"
+}
+
+func findTenantVariableTemplate(templates []*tenantVariableTemplate, templateID string) *tenantVariableTemplate {
+	for _, template := range templates {
+		if template.ID == templateID {
+			return template
+		}
+	}
+	return nil
+}
+
+// expandTenantVariableValue encodes the value of a tenant variable. Sensitive
+// values are sent as new values of a sensitive value.
+func expandTenantVariableValue(template *tenantVariableTemplate, value string) (json.RawMessage, error) {
+	if template.isSensitive() {
+		return json.Marshal(octopusdeploy.NewSensitiveValue(value))
+	}
+	return json.Marshal(value)
+}
+
+// flattenTenantVariableValue decodes the value of a tenant variable. The
+// value of a sensitive variable is never returned by the server; the current
+// value is returned instead as long as the server reports that a value is
+// set. The boolean result is false if no value is set.
+func flattenTenantVariableValue(rawValue json.RawMessage, currentValue string) (string, bool) {
+	if len(rawValue) == 0 || string(rawValue) == "null" {
+		return "", false
+	}
+
+	var value string
+	if err := json.Unmarshal(rawValue, &value); err == nil {
+		return value, true
+	}
+
+	var sensitiveValue octopusdeploy.SensitiveValue
+	if err := json.Unmarshal(rawValue, &sensitiveValue); err == nil && sensitiveValue.HasValue {
+		return currentValue, true
+	}
+
+	return "", false
+}
+
+func getTenantVariablesPath(client *octopusdeploy.Client, tenantID string) (string, error) {
+	path, err := client.Tenants.URITemplate.Expand(map[string]interface{}{"id": tenantID})
+	if err != nil {
+		return "", err
+	}
+	return path + "/variables", nil
+}
+
+func getTenantVariables(client *octopusdeploy.Client, tenantID string) (*tenantVariables, error) {
+	path, err := getTenantVariablesPath(client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := &tenantVariables{}
+	if err := apiGet(client.Tenants.Sling, path, variables); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+func updateTenantVariables(client *octopusdeploy.Client, variables *tenantVariables) (*tenantVariables, error) {
+	path, err := getTenantVariablesPath(client, variables.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedVariables := &tenantVariables{}
+	if err := apiUpdate(client.Tenants.Sling, path, variables, updatedVariables); err != nil {
+		return nil, err
+	}
+
+	return updatedVariables, nil
+}
+
+func (v *tenantVariables) getLibraryVariables(libraryVariableSetID string) (*tenantLibraryVariables, error) {
+	libraryVariables, ok := v.LibraryVariables[libraryVariableSetID]
+	if !ok {
+		return nil, fmt.Errorf("tenant (%s) is not connected to a project that includes library variable set (%s)", v.TenantID, libraryVariableSetID)
+	}
+
+	if libraryVariables.Variables == nil {
+		libraryVariables.Variables = map[string]json.RawMessage{}
+	}
+
+	return libraryVariables, nil
+}
+
+func (v *tenantVariables) getProjectVariables(projectID string, environmentID string) (*tenantProjectVariables, error) {
+	projectVariables, ok := v.ProjectVariables[projectID]
+	if !ok {
+		return nil, fmt.Errorf("tenant (%s) is not connected to project (%s)", v.TenantID, projectID)
+	}
+
+	if projectVariables.Variables == nil {
+		projectVariables.Variables = map[string]map[string]json.RawMessage{}
+	}
+
+	if projectVariables.Variables[environmentID] == nil {
+		projectVariables.Variables[environmentID] = map[string]json.RawMessage{}
+	}
+
+	return projectVariables, nil
+}
+
+// validateTenantCommonVariableTemplate checks that the template belongs to
+// the library variable set. Whether the tenant is connected to a project that
+// includes the library variable set is only checked when the value is written
+// since the connection may be created by the same apply.
+func validateTenantCommonVariableTemplate(client *octopusdeploy.Client, libraryVariableSetID string, templateID string) error {
+	libraryVariableSet, err := client.LibraryVariableSets.GetByID(libraryVariableSetID)
+	if err != nil {
+		return err
+	}
+
+	if !hasActionTemplateParameter(libraryVariableSet.Templates, templateID) {
+		return fmt.Errorf("template (%s) does not belong to library variable set (%s)", templateID, libraryVariableSetID)
+	}
+
+	return nil
+}
+
+// validateTenantProjectVariableTemplate checks that the template belongs to
+// the project. Whether the tenant is connected to the project and environment
+// is only checked when the value is written since the connection may be
+// created by the same apply (e.g. by octopusdeploy_tenant_project).
+func validateTenantProjectVariableTemplate(client *octopusdeploy.Client, projectID string, templateID string) error {
+	project, err := client.Projects.GetByID(projectID)
+	if err != nil {
+		return err
+	}
+
+	if !hasActionTemplateParameter(project.Templates, templateID) {
+		return fmt.Errorf("template (%s) does not belong to project (%s)", templateID, projectID)
+	}
+
+	return nil
+}
+
+// validateTenantProjectEnvironment checks that the tenant is connected to the
+// environment of the project.
+func validateTenantProjectEnvironment(client *octopusdeploy.Client, tenantID string, projectID string, environmentID string) error {
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return err
+	}
+
+	environmentIDs, ok := tenant.ProjectEnvironments[projectID]
+	if !ok {
+		return fmt.Errorf("tenant (%s) is not connected to project (%s)", tenantID, projectID)
+	}
+
+	if !validateStringInSlice(environmentID, environmentIDs) {
+		return fmt.Errorf("tenant (%s) is not connected to environment (%s) of project (%s)", tenantID, environmentID, projectID)
+	}
+
+	return nil
+}
+
+func hasActionTemplateParameter(templates []octopusdeploy.ActionTemplateParameter, templateID string) bool {
+	for _, template := range templates {
+		if template.GetID() == templateID {
+			return true
+		}
+	}
+	return false
+}
+
+// isTenantVariableDiffValidatable reports whether the identifying attributes
+// of a tenant variable are known and changed (or new) such that they can be
+// validated against the server during planning.
+func isTenantVariableDiffValidatable(d *schema.ResourceDiff, keys []string) bool {
+	hasChange := len(d.Id()) == 0
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+		hasChange = hasChange || d.HasChange(key)
+	}
+	return hasChange
+}
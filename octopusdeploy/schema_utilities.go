@@ -1,20 +1,25 @@
 package octopusdeploy
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func getAccountTypeSchema(isRequired bool) *schema.Schema {
 	schema := &schema.Schema{
-		Description: "Specifies the type of the account. Valid account types are `AmazonWebServicesAccount`, `AmazonWebServicesRoleAccount`, `AzureServicePrincipal`, `AzureSubscription`, `None`, `SshKeyPair`, `Token`, or `UsernamePassword`.",
+		Description: "Specifies the type of the account. Valid account types are `AmazonWebServicesAccount`, `AmazonWebServicesOidcAccount`, `AmazonWebServicesRoleAccount`, `AzureOidc`, `AzureServicePrincipal`, `AzureSubscription`, `GoogleCloudAccount`, `None`, `SshKeyPair`, `Token`, or `UsernamePassword`.",
 		ForceNew:    true,
 		Type:        schema.TypeString,
 		ValidateDiagFunc: validateValueFunc([]string{
 			"AmazonWebServicesAccount",
+			"AmazonWebServicesOidcAccount",
 			"AmazonWebServicesRoleAccount",
+			"AzureOidc",
 			"AzureServicePrincipal",
 			"AzureSubscription",
+			"GoogleCloudAccount",
 			"None",
 			"SshKeyPair",
 			"Token",
@@ -31,6 +36,23 @@ func getAccountTypeSchema(isRequired bool) *schema.Schema {
 	return schema
 }
 
+func getAccountTestSubjectKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The keys that are included in the subject claim of the token that is requested when testing this account. Valid keys are `space`, `account`, or `type`.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"account",
+				"space",
+				"type",
+			}, false)),
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
+
 func getAccessKeySchema(isRequired bool) *schema.Schema {
 	schema := &schema.Schema{
 		Description: "The access key associated with this resource.",
@@ -109,6 +131,27 @@ func getCertificateDataFormatSchema() *schema.Schema {
 	}
 }
 
+func getDeploymentSubjectKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The keys that are included in the subject claim of the token that is requested for deployments and runbook runs. Valid keys are `space`, `environment`, `project`, `tenant`, `runbook`, `account`, or `type`.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"account",
+				"environment",
+				"project",
+				"runbook",
+				"space",
+				"tenant",
+				"type",
+			}, false)),
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
+
 func getDescriptionSchema() *schema.Schema {
 	return &schema.Schema{
 		Description: "The description of this resource.",
@@ -157,6 +200,24 @@ func getEnvironmentsSchema() *schema.Schema {
 	}
 }
 
+func getHealthSubjectKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The keys that are included in the subject claim of the token that is requested for health checks. Valid keys are `space`, `account`, `target`, or `type`.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"account",
+				"space",
+				"target",
+				"type",
+			}, false)),
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
+
 func getHealthStatusSchema() *schema.Schema {
 	return &schema.Schema{
 		Computed:    true,
@@ -191,6 +252,23 @@ func getIsSensitiveSchema() *schema.Schema {
 	}
 }
 
+func getJSONKeySchema(isRequired bool) *schema.Schema {
+	schema := &schema.Schema{
+		Description:      "The JSON key associated with this resource.",
+		Sensitive:        true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+	}
+
+	if isRequired {
+		schema.Required = true
+	} else {
+		schema.Optional = true
+	}
+
+	return schema
+}
+
 func getPasswordSchema(isRequired bool) *schema.Schema {
 	schema := &schema.Schema{
 		Description:      "The password associated with this resource.",
@@ -240,6 +318,22 @@ func getResourceManagerEndpointSchema(isRequired bool) *schema.Schema {
 	return schema
 }
 
+func getRoleArnSchema(isRequired bool) *schema.Schema {
+	schema := &schema.Schema{
+		Description:      "The Amazon Resource Name (ARN) of the role that is assumed by this resource.",
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`), "must be the ARN of an IAM role")),
+	}
+
+	if isRequired {
+		schema.Required = true
+	} else {
+		schema.Optional = true
+	}
+
+	return schema
+}
+
 func getSecretKeySchema(isRequired bool) *schema.Schema {
 	schema := &schema.Schema{
 		Description: "The secret key associated with this resource.",
@@ -256,6 +350,16 @@ func getSecretKeySchema(isRequired bool) *schema.Schema {
 	return schema
 }
 
+func getSessionDurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Default:          3600,
+		Description:      "The duration, in seconds, of the role session. Valid durations are between `900` (15 minutes) and `43200` (12 hours).",
+		Optional:         true,
+		Type:             schema.TypeInt,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(900, 43200)),
+	}
+}
+
 func getSortOrderSchema() *schema.Schema {
 	return &schema.Schema{
 		Computed:    true,
@@ -401,13 +505,14 @@ func getUsernameSchema(isRequired bool) *schema.Schema {
 
 func getVariableTypeSchema() *schema.Schema {
 	return &schema.Schema{
-		Description: "The type of variable represented by this resource. Valid types are `AmazonWebServicesAccount`, `AzureAccount`, `Certificate`, `Sensitive`, `String`, or `WorkerPool`.",
+		Description: "The type of variable represented by this resource. Valid types are `AmazonWebServicesAccount`, `AzureAccount`, `Certificate`, `GoogleCloudAccount`, `Sensitive`, `String`, or `WorkerPool`.",
 		Required:    true,
 		Type:        schema.TypeString,
 		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
 			"AmazonWebServicesAccount",
 			"AzureAccount",
 			"Certificate",
+			"GoogleCloudAccount",
 			"Sensitive",
 			"String",
 			"WorkerPool",
@@ -1,31 +1,76 @@
 package octopusdeploy
 
 import (
+	"fmt"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func expandVersionControlSettings(flattenedVersionControlSettings interface{}) *octopusdeploy.VersionControlSettings {
+// versionControlSettings represents the version control settings of a project
+// along with credentials that reference git credentials, which are not
+// supported by the client.
+type versionControlSettings struct {
+	Credentials *versionControlCredentials `json:"Credentials,omitempty"`
+
+	octopusdeploy.VersionControlSettings
+}
+
+type versionControlCredentials struct {
+	ID   string `json:"Id,omitempty"`
+	Type string `json:"Type"`
+}
+
+func expandVersionControlSettings(flattenedVersionControlSettings interface{}) (*versionControlSettings, error) {
 	versionControlSettingsList := flattenedVersionControlSettings.(*schema.Set).List()
 	versionControlSettingsMap := versionControlSettingsList[0].(map[string]interface{})
 
-	versionControlSettings := &octopusdeploy.VersionControlSettings{
-		BasePath:      versionControlSettingsMap["base_path"].(string),
-		DefaultBranch: versionControlSettingsMap["default_branch"].(string),
-		HasValue:      versionControlSettingsMap["has_value"].(bool),
-		URL:           versionControlSettingsMap["url"].(string),
-		Username:      versionControlSettingsMap["username"].(string),
+	if err := validateVersionControlSettings(versionControlSettingsMap); err != nil {
+		return nil, err
 	}
 
+	versionControlSettings := &versionControlSettings{
+		VersionControlSettings: octopusdeploy.VersionControlSettings{
+			BasePath:      versionControlSettingsMap["base_path"].(string),
+			DefaultBranch: versionControlSettingsMap["default_branch"].(string),
+			HasValue:      versionControlSettingsMap["has_value"].(bool),
+			URL:           versionControlSettingsMap["url"].(string),
+		},
+	}
+
+	if gitCredentialID := versionControlSettingsMap["git_credential_id"].(string); len(gitCredentialID) > 0 {
+		versionControlSettings.Credentials = &versionControlCredentials{
+			ID:   gitCredentialID,
+			Type: "Reference",
+		}
+		return versionControlSettings, nil
+	}
+
+	versionControlSettings.Username = versionControlSettingsMap["username"].(string)
+
 	if password := versionControlSettingsMap["password"].(string); len(password) > 0 {
 		versionControlSettings.Password = octopusdeploy.NewSensitiveValue(password)
 	}
 
-	return versionControlSettings
+	return versionControlSettings, nil
 }
 
-func flattenVersionControlSettings(versionControlSettings *octopusdeploy.VersionControlSettings) []interface{} {
+// validateVersionControlSettings checks that a reference to git credentials
+// is not combined with a username or password.
+func validateVersionControlSettings(versionControlSettingsMap map[string]interface{}) error {
+	if len(versionControlSettingsMap["git_credential_id"].(string)) == 0 {
+		return nil
+	}
+
+	if len(versionControlSettingsMap["username"].(string)) > 0 || len(versionControlSettingsMap["password"].(string)) > 0 {
+		return fmt.Errorf("git_credential_id cannot be used together with username or password in version_control_settings")
+	}
+
+	return nil
+}
+
+func flattenVersionControlSettings(versionControlSettings *versionControlSettings) []interface{} {
 	if versionControlSettings == nil {
 		return nil
 	}
@@ -36,6 +81,11 @@ func flattenVersionControlSettings(versionControlSettings *octopusdeploy.Version
 	flattenedVersionControlSettings["has_value"] = versionControlSettings.HasValue
 	flattenedVersionControlSettings["url"] = versionControlSettings.URL
 	flattenedVersionControlSettings["username"] = versionControlSettings.Username
+
+	if credentials := versionControlSettings.Credentials; credentials != nil && credentials.Type == "Reference" {
+		flattenedVersionControlSettings["git_credential_id"] = credentials.ID
+	}
+
 	return []interface{}{flattenedVersionControlSettings}
 }
 
@@ -51,12 +101,17 @@ func getVersionControlSettingsSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"git_credential_id": {
+			Description: "The ID of the git credential used by these version control settings. It cannot be used together with `username` or `password`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
 		"has_value": {
 			Type:     schema.TypeBool,
 			Optional: true,
 		},
 		"password": {
-			Description:      "The password associated with these version control settings.",
+			Description:      "The password associated with these version control settings. It cannot be used together with `git_credential_id`.",
 			Sensitive:        true,
 			Optional:         true,
 			Type:             schema.TypeString,
@@ -68,7 +123,7 @@ func getVersionControlSettingsSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"username": {
-			Description:      "The username associated with these version control settings.",
+			Description:      "The username associated with these version control settings. It cannot be used together with `git_credential_id`.",
 			Optional:         true,
 			Sensitive:        true,
 			Type:             schema.TypeString,
@@ -0,0 +1,203 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandWorker(d *schema.ResourceData, endpoint octopusdeploy.IEndpoint) *octopusdeploy.Worker {
+	name := d.Get("name").(string)
+
+	worker := octopusdeploy.NewWorker(name, endpoint)
+	worker.ID = d.Id()
+	worker.WorkerPoolIDs = getSliceFromTerraformTypeList(d.Get("worker_pool_ids"))
+
+	if v, ok := d.GetOk("is_disabled"); ok {
+		worker.IsDisabled = v.(bool)
+	}
+
+	if v, ok := d.GetOk("machine_policy_id"); ok {
+		worker.MachinePolicyID = v.(string)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		worker.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("thumbprint"); ok {
+		worker.Thumbprint = v.(string)
+	}
+
+	if v, ok := d.GetOk("uri"); ok {
+		worker.URI = v.(string)
+	}
+
+	return worker
+}
+
+func flattenWorker(worker *octopusdeploy.Worker) map[string]interface{} {
+	if worker == nil {
+		return nil
+	}
+
+	endpointResource, _ := octopusdeploy.ToEndpointResource(worker.Endpoint)
+
+	return map[string]interface{}{
+		"endpoint":            flattenEndpointResource(endpointResource),
+		"has_latest_calamari": worker.HasLatestCalamari,
+		"health_status":       worker.HealthStatus,
+		"id":                  worker.GetID(),
+		"is_disabled":         worker.IsDisabled,
+		"is_in_process":       worker.IsInProcess,
+		"machine_policy_id":   worker.MachinePolicyID,
+		"name":                worker.Name,
+		"operating_system":    worker.OperatingSystem,
+		"shell_name":          worker.ShellName,
+		"shell_version":       worker.ShellVersion,
+		"space_id":            worker.SpaceID,
+		"status":              worker.Status,
+		"status_summary":      worker.StatusSummary,
+		"thumbprint":          worker.Thumbprint,
+		"uri":                 worker.URI,
+		"worker_pool_ids":     worker.WorkerPoolIDs,
+	}
+}
+
+func getWorkerDataSchema() map[string]*schema.Schema {
+	dataSchema := getWorkerSchema()
+	setDataSchema(&dataSchema)
+
+	return map[string]*schema.Schema{
+		"communication_styles": getQueryCommunicationStyles(),
+		"health_statuses":      getQueryHealthStatuses(),
+		"id":                   getDataSchemaID(),
+		"ids":                  getQueryIDs(),
+		"is_disabled":          getQueryIsDisabled(),
+		"name":                 getQueryName(),
+		"partial_name":         getQueryPartialName(),
+		"skip":                 getQuerySkip(),
+		"take":                 getQueryTake(),
+		"worker_pool_ids": {
+			Description: "A filter to search by a list of worker pool IDs.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"workers": {
+			Computed:    true,
+			Description: "A list of workers that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func getWorkerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint": {
+			Computed: true,
+			Elem:     &schema.Resource{Schema: getEndpointSchema()},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"has_latest_calamari": {
+			Computed: true,
+			Type:     schema.TypeBool,
+		},
+		"health_status": getHealthStatusSchema(),
+		"id":            getIDSchema(),
+		"is_disabled": {
+			Computed:    true,
+			Description: "Represents the disabled status of this worker.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"is_in_process": {
+			Computed:    true,
+			Description: "Represents the in-process status of this worker.",
+			Type:        schema.TypeBool,
+		},
+		"machine_policy_id": {
+			Computed:    true,
+			Description: "The machine policy ID that is associated with this worker. The default machine policy of the space is used if this is not specified.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"name": getNameSchema(true),
+		"operating_system": {
+			Computed:    true,
+			Description: "The operating system that is associated with this worker.",
+			Type:        schema.TypeString,
+		},
+		"shell_name": {
+			Computed:    true,
+			Description: "The shell name associated with this worker.",
+			Type:        schema.TypeString,
+		},
+		"shell_version": {
+			Computed:    true,
+			Description: "The shell version associated with this worker.",
+			Type:        schema.TypeString,
+		},
+		"space_id":       getSpaceIDSchema(),
+		"status":         getStatusSchema(),
+		"status_summary": getStatusSummarySchema(),
+		"thumbprint": {
+			Computed:    true,
+			Description: "The thumbprint of this worker.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"uri": {
+			Computed:    true,
+			Description: "The URI of this worker.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"worker_pool_ids": {
+			Description: "A list of worker pool IDs that this worker is registered into.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    1,
+			Required:    true,
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+// getTypedWorkerSchema returns the common worker schema without the generic
+// endpoint block, which is replaced by the endpoint-specific attributes of
+// the typed worker resources.
+func getTypedWorkerSchema() map[string]*schema.Schema {
+	workerSchema := getWorkerSchema()
+	delete(workerSchema, "endpoint")
+	return workerSchema
+}
+
+func setWorker(ctx context.Context, d *schema.ResourceData, worker *octopusdeploy.Worker) error {
+	d.Set("has_latest_calamari", worker.HasLatestCalamari)
+	d.Set("health_status", worker.HealthStatus)
+	d.Set("is_disabled", worker.IsDisabled)
+	d.Set("is_in_process", worker.IsInProcess)
+	d.Set("machine_policy_id", worker.MachinePolicyID)
+	d.Set("name", worker.Name)
+	d.Set("operating_system", worker.OperatingSystem)
+	d.Set("shell_name", worker.ShellName)
+	d.Set("shell_version", worker.ShellVersion)
+	d.Set("space_id", worker.SpaceID)
+	d.Set("status", worker.Status)
+	d.Set("status_summary", worker.StatusSummary)
+	d.Set("thumbprint", worker.Thumbprint)
+	d.Set("uri", worker.URI)
+
+	if err := d.Set("worker_pool_ids", worker.WorkerPoolIDs); err != nil {
+		return fmt.Errorf("error setting worker_pool_ids: %s", err)
+	}
+
+	d.SetId(worker.GetID())
+
+	return nil
+}
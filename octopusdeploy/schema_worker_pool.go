@@ -0,0 +1,173 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandWorkerPool(d *schema.ResourceData) *octopusdeploy.WorkerPoolResource {
+	workerPool := &octopusdeploy.WorkerPoolResource{
+		Name:           d.Get("name").(string),
+		WorkerPoolType: octopusdeploy.WorkerPoolType(d.Get("worker_pool_type").(string)),
+	}
+	workerPool.ID = d.Id()
+
+	if v, ok := d.GetOk("description"); ok {
+		workerPool.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("is_default"); ok {
+		workerPool.IsDefault = v.(bool)
+	}
+
+	if v, ok := d.GetOk("sort_order"); ok {
+		workerPool.SortOrder = v.(int)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		workerPool.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		workerPool.WorkerType = octopusdeploy.WorkerType(v.(string))
+	}
+
+	return workerPool
+}
+
+func flattenWorkerPool(workerPool *octopusdeploy.WorkerPoolResource) map[string]interface{} {
+	if workerPool == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"can_add_workers":  workerPool.CanAddWorkers,
+		"description":      workerPool.Description,
+		"id":               workerPool.GetID(),
+		"is_default":       workerPool.IsDefault,
+		"name":             workerPool.Name,
+		"sort_order":       workerPool.SortOrder,
+		"space_id":         workerPool.SpaceID,
+		"worker_pool_type": workerPool.WorkerPoolType,
+		"worker_type":      workerPool.WorkerType,
+	}
+}
+
+// toWorkerPoolResource converts the static and dynamic worker pools returned
+// by the worker pool service into a single worker pool resource.
+func toWorkerPoolResource(workerPool octopusdeploy.IWorkerPool) *octopusdeploy.WorkerPoolResource {
+	var workerPoolResource *octopusdeploy.WorkerPoolResource
+
+	switch v := workerPool.(type) {
+	case *octopusdeploy.WorkerPoolResource:
+		return v
+	case *octopusdeploy.DynamicWorkerPool:
+		workerPoolResource = newWorkerPoolResourceFrom(&v.WorkerPool)
+		workerPoolResource.WorkerType = v.WorkerType
+	case *octopusdeploy.StaticWorkerPool:
+		workerPoolResource = newWorkerPoolResourceFrom(&v.WorkerPool)
+	default:
+		return nil
+	}
+
+	workerPoolResource.WorkerPoolType = workerPool.GetWorkerPoolType()
+	return workerPoolResource
+}
+
+func newWorkerPoolResourceFrom(workerPool *octopusdeploy.WorkerPool) *octopusdeploy.WorkerPoolResource {
+	workerPoolResource := &octopusdeploy.WorkerPoolResource{
+		CanAddWorkers: workerPool.CanAddWorkers,
+		Description:   workerPool.Description,
+		IsDefault:     workerPool.IsDefault,
+		Name:          workerPool.Name,
+		SortOrder:     workerPool.SortOrder,
+		SpaceID:       workerPool.SpaceID,
+	}
+	workerPoolResource.ID = workerPool.GetID()
+	workerPoolResource.Links = workerPool.Links
+
+	return workerPoolResource
+}
+
+func getWorkerPoolDataSchema() map[string]*schema.Schema {
+	dataSchema := getWorkerPoolSchema()
+	setDataSchema(&dataSchema)
+
+	return map[string]*schema.Schema{
+		"id":               getDataSchemaID(),
+		"ids":              getQueryIDs(),
+		"name":             getQueryName(),
+		"partial_name":     getQueryPartialName(),
+		"skip":             getQuerySkip(),
+		"take":             getQueryTake(),
+		"worker_pool_type": getQueryWorkerPoolType(),
+		"worker_pools": {
+			Computed:    true,
+			Description: "A list of worker pools that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func getWorkerPoolSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"can_add_workers": {
+			Computed:    true,
+			Description: "Indicates whether or not workers can be added to this worker pool. Only static worker pools accept workers.",
+			Type:        schema.TypeBool,
+		},
+		"description": getDescriptionSchema(),
+		"id":          getIDSchema(),
+		"is_default": {
+			Description: "Indicates whether or not this is the default worker pool of the space. Setting this on a worker pool removes the flag from the previous default worker pool. On destroy, the flag is removed before the worker pool is deleted; if the server keeps the worker pool as the default, it is only removed from the state.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name":       getNameSchema(true),
+		"sort_order": getSortOrderSchema(),
+		"space_id":   getSpaceIDSchema(),
+		"worker_pool_type": {
+			Default:     "StaticWorkerPool",
+			Description: "The type of this worker pool. Valid types are `DynamicWorkerPool` or `StaticWorkerPool`.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"DynamicWorkerPool",
+				"StaticWorkerPool",
+			}, false)),
+		},
+		"worker_type": {
+			Description: "The type of worker provisioned by a dynamic worker pool. Valid worker types are `Ubuntu1804`, `UbuntuDefault`, `Windows2016`, `Windows2019`, or `WindowsDefault`. Required for dynamic worker pools.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"Ubuntu1804",
+				"UbuntuDefault",
+				"Windows2016",
+				"Windows2019",
+				"WindowsDefault",
+			}, false)),
+		},
+	}
+}
+
+func setWorkerPool(ctx context.Context, d *schema.ResourceData, workerPool *octopusdeploy.WorkerPoolResource) error {
+	d.Set("can_add_workers", workerPool.CanAddWorkers)
+	d.Set("description", workerPool.Description)
+	d.Set("is_default", workerPool.IsDefault)
+	d.Set("name", workerPool.Name)
+	d.Set("sort_order", workerPool.SortOrder)
+	d.Set("space_id", workerPool.SpaceID)
+	d.Set("worker_pool_type", workerPool.WorkerPoolType)
+	d.Set("worker_type", workerPool.WorkerType)
+
+	d.SetId(workerPool.GetID())
+
+	return nil
+}
@@ -1,8 +1,11 @@
 package octopusdeploy
 
 import (
+	"fmt"
 	"log"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -109,3 +112,89 @@ func getStringOrEmpty(tfAttr interface{}) string {
 	}
 	return tfAttr.(string)
 }
+
+// suppressEquivalentTimeDiffs suppresses differences between timestamps that
+// represent the same instant (e.g. "2021-01-01T10:00:00+10:00" and
+// "2021-01-01T00:00:00.000Z").
+func suppressEquivalentTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := parseTime(old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := parseTime(new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse("2006-01-02T15:04:05", value)
+}
+
+// suppressEquivalentDurationDiffs suppresses differences between durations
+// that represent the same length of time (e.g. "1h" and "1h0m0s").
+func suppressEquivalentDurationDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDuration == newDuration
+}
+
+// formatTimeSpan formats a duration as a .NET TimeSpan ([d.]hh:mm:ss), which
+// is how durations are represented by the Octopus API.
+func formatTimeSpan(duration time.Duration) string {
+	days := duration / (24 * time.Hour)
+	duration -= days * 24 * time.Hour
+	hours := duration / time.Hour
+	duration -= hours * time.Hour
+	minutes := duration / time.Minute
+	duration -= minutes * time.Minute
+	seconds := duration / time.Second
+
+	timeSpan := fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
+	if days > 0 {
+		timeSpan = fmt.Sprintf("%d.%s", days, timeSpan)
+	}
+
+	return timeSpan
+}
+
+// parseTimeSpan parses a .NET TimeSpan ([d.]hh:mm:ss[.fffffff]) as a
+// duration.
+func parseTimeSpan(timeSpan string) (time.Duration, error) {
+	var days, hours, minutes int
+	var seconds float64
+
+	value := timeSpan
+	if i := strings.Index(value, "."); i >= 0 && i < strings.Index(value, ":") {
+		d, err := strconv.Atoi(value[:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid time span %q", timeSpan)
+		}
+		days = d
+		value = value[i+1:]
+	}
+
+	if _, err := fmt.Sscanf(value, "%d:%d:%g", &hours, &minutes, &seconds); err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	return time.Duration(days)*24*time.Hour +
+		time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), nil
+}
@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,3 +18,27 @@ func TestGetSliceFromTerraformTypeList(t *testing.T) {
 
 	assert.Nil(t, slice)
 }
+
+func TestFormatTimeSpan(t *testing.T) {
+	assert.Equal(t, "00:00:10", formatTimeSpan(10*time.Second))
+	assert.Equal(t, "01:30:00", formatTimeSpan(90*time.Minute))
+	assert.Equal(t, "2.03:04:05", formatTimeSpan(51*time.Hour+4*time.Minute+5*time.Second))
+}
+
+func TestParseTimeSpan(t *testing.T) {
+	for timeSpan, expected := range map[string]time.Duration{
+		"00:00:10":         10 * time.Second,
+		"01:30:00":         90 * time.Minute,
+		"2.03:04:05":       51*time.Hour + 4*time.Minute + 5*time.Second,
+		"00:00:01.5000000": 1500 * time.Millisecond,
+	} {
+		duration, err := parseTimeSpan(timeSpan)
+		assert.NoError(t, err, timeSpan)
+		assert.Equal(t, expected, duration, timeSpan)
+	}
+
+	for _, timeSpan := range []string{"", "10", "1h", "x.00:00:00"} {
+		_, err := parseTimeSpan(timeSpan)
+		assert.Error(t, err, timeSpan)
+	}
+}
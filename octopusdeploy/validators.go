@@ -0,0 +1,328 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// embeds the IANA time zone database so that time zones are validated
+	// consistently regardless of the host that runs Terraform
+	_ "time/tzdata"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "seconds", min: 0, max: 59},
+	{name: "minutes", min: 0, max: 59},
+	{name: "hours", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// validateCronExpression validates a cron expression in the format that is
+// supported by Octopus Deploy: seconds, minutes, hours, day-of-month, month
+// and day-of-week (e.g. "0 0 06 * * Mon-Fri").
+func validateCronExpression(v interface{}, path cty.Path) diag.Diagnostics {
+	cronExpression := v.(string)
+
+	fields := strings.Fields(cronExpression)
+	if len(fields) != len(cronFields) {
+		return diag.Errorf("invalid cron expression %q; expected %d fields (seconds, minutes, hours, day-of-month, month, and day-of-week) but found %d", cronExpression, len(cronFields), len(fields))
+	}
+
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			return diag.Errorf("invalid cron expression %q; %s", cronExpression, err)
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) validate(field string) error {
+	for _, item := range strings.Split(field, ",") {
+		if err := f.validateItem(item); err != nil {
+			return fmt.Errorf("invalid %s field %q: %s", f.name, field, err)
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) validateItem(item string) error {
+	if len(item) == 0 {
+		return fmt.Errorf("empty value")
+	}
+
+	base := item
+	if i := strings.Index(item, "/"); i >= 0 {
+		base = item[:i]
+		step, err := strconv.Atoi(item[i+1:])
+		if err != nil || step < 1 || step > f.max {
+			return fmt.Errorf("invalid step %q", item[i+1:])
+		}
+	}
+
+	switch {
+	case base == "*":
+		return nil
+	case base == "?":
+		if f.name != "day-of-month" && f.name != "day-of-week" {
+			return fmt.Errorf("'?' is only supported by the day-of-month and day-of-week fields")
+		}
+		return nil
+	case f.name == "day-of-month" && (base == "L" || base == "LW"):
+		return nil
+	case f.name == "day-of-month" && strings.HasPrefix(base, "L-"):
+		return f.validateValue(base[2:])
+	case f.name == "day-of-month" && strings.HasSuffix(base, "W"):
+		return f.validateValue(strings.TrimSuffix(base, "W"))
+	case f.name == "day-of-week" && strings.HasSuffix(base, "L"):
+		return f.validateValue(strings.TrimSuffix(base, "L"))
+	case f.name == "day-of-week" && strings.Contains(base, "#"):
+		parts := strings.SplitN(base, "#", 2)
+		if n, err := strconv.Atoi(parts[1]); err != nil || n < 1 || n > 5 {
+			return fmt.Errorf("invalid occurrence %q", parts[1])
+		}
+		return f.validateValue(parts[0])
+	case strings.Contains(base, "-"):
+		parts := strings.SplitN(base, "-", 2)
+		from, err := f.parseValue(parts[0])
+		if err != nil {
+			return err
+		}
+		to, err := f.parseValue(parts[1])
+		if err != nil {
+			return err
+		}
+		if from > to {
+			return fmt.Errorf("invalid range %q", base)
+		}
+		return nil
+	}
+
+	return f.validateValue(base)
+}
+
+func (f cronField) validateValue(value string) error {
+	_, err := f.parseValue(value)
+	return err
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %q is out of range (%d-%d)", value, f.min, f.max)
+	}
+
+	return n, nil
+}
+
+// validateEventFilter validates the event groups or event categories of an
+// event filter (e.g. of a trigger or subscription) against the valid values.
+func validateEventFilter(name string, values []string, validValues []string) error {
+	if invalidValue, ok := validateAllSliceItemsInSlice(values, validValues); !ok {
+		return fmt.Errorf("Invalid value for %s. %s not in %v", name, invalidValue, validValues)
+	}
+
+	return nil
+}
+
+// validateTimeZone validates a time zone identifier. Both IANA (e.g.
+// "Australia/Brisbane") and Windows (e.g. "E. Australia Standard Time") time
+// zone identifiers are supported by Octopus Deploy.
+func validateTimeZone(v interface{}, path cty.Path) diag.Diagnostics {
+	timeZone := v.(string)
+
+	if validateStringInSlice(timeZone, windowsTimeZones) {
+		return nil
+	}
+
+	if isEmpty(timeZone) || timeZone == "Local" {
+		return diag.Errorf("invalid time zone %q", timeZone)
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return diag.Errorf("invalid time zone %q; expected an IANA or Windows time zone identifier", timeZone)
+	}
+
+	return nil
+}
+
+var windowsTimeZones = []string{
+	"Afghanistan Standard Time",
+	"Alaskan Standard Time",
+	"Aleutian Standard Time",
+	"Altai Standard Time",
+	"Arab Standard Time",
+	"Arabian Standard Time",
+	"Arabic Standard Time",
+	"Argentina Standard Time",
+	"Astrakhan Standard Time",
+	"Atlantic Standard Time",
+	"AUS Central Standard Time",
+	"Aus Central W. Standard Time",
+	"AUS Eastern Standard Time",
+	"Azerbaijan Standard Time",
+	"Azores Standard Time",
+	"Bahia Standard Time",
+	"Bangladesh Standard Time",
+	"Belarus Standard Time",
+	"Bougainville Standard Time",
+	"Canada Central Standard Time",
+	"Cape Verde Standard Time",
+	"Caucasus Standard Time",
+	"Cen. Australia Standard Time",
+	"Central America Standard Time",
+	"Central Asia Standard Time",
+	"Central Brazilian Standard Time",
+	"Central Europe Standard Time",
+	"Central European Standard Time",
+	"Central Pacific Standard Time",
+	"Central Standard Time",
+	"Central Standard Time (Mexico)",
+	"Chatham Islands Standard Time",
+	"China Standard Time",
+	"Cuba Standard Time",
+	"Dateline Standard Time",
+	"E. Africa Standard Time",
+	"E. Australia Standard Time",
+	"E. Europe Standard Time",
+	"E. South America Standard Time",
+	"Easter Island Standard Time",
+	"Eastern Standard Time",
+	"Eastern Standard Time (Mexico)",
+	"Egypt Standard Time",
+	"Ekaterinburg Standard Time",
+	"Fiji Standard Time",
+	"FLE Standard Time",
+	"Georgian Standard Time",
+	"GMT Standard Time",
+	"Greenland Standard Time",
+	"Greenwich Standard Time",
+	"GTB Standard Time",
+	"Haiti Standard Time",
+	"Hawaiian Standard Time",
+	"India Standard Time",
+	"Iran Standard Time",
+	"Israel Standard Time",
+	"Jordan Standard Time",
+	"Kaliningrad Standard Time",
+	"Kamchatka Standard Time",
+	"Korea Standard Time",
+	"Libya Standard Time",
+	"Line Islands Standard Time",
+	"Lord Howe Standard Time",
+	"Magadan Standard Time",
+	"Magallanes Standard Time",
+	"Marquesas Standard Time",
+	"Mauritius Standard Time",
+	"Mid-Atlantic Standard Time",
+	"Middle East Standard Time",
+	"Montevideo Standard Time",
+	"Morocco Standard Time",
+	"Mountain Standard Time",
+	"Mountain Standard Time (Mexico)",
+	"Myanmar Standard Time",
+	"N. Central Asia Standard Time",
+	"Namibia Standard Time",
+	"Nepal Standard Time",
+	"New Zealand Standard Time",
+	"Newfoundland Standard Time",
+	"Norfolk Standard Time",
+	"North Asia East Standard Time",
+	"North Asia Standard Time",
+	"North Korea Standard Time",
+	"Omsk Standard Time",
+	"Pacific SA Standard Time",
+	"Pacific Standard Time",
+	"Pacific Standard Time (Mexico)",
+	"Pakistan Standard Time",
+	"Paraguay Standard Time",
+	"Qyzylorda Standard Time",
+	"Romance Standard Time",
+	"Russia Time Zone 10",
+	"Russia Time Zone 11",
+	"Russia Time Zone 3",
+	"Russian Standard Time",
+	"SA Eastern Standard Time",
+	"SA Pacific Standard Time",
+	"SA Western Standard Time",
+	"Saint Pierre Standard Time",
+	"Sakhalin Standard Time",
+	"Samoa Standard Time",
+	"Sao Tome Standard Time",
+	"Saratov Standard Time",
+	"SE Asia Standard Time",
+	"Singapore Standard Time",
+	"South Africa Standard Time",
+	"South Sudan Standard Time",
+	"Sri Lanka Standard Time",
+	"Sudan Standard Time",
+	"Syria Standard Time",
+	"Taipei Standard Time",
+	"Tasmania Standard Time",
+	"Tocantins Standard Time",
+	"Tokyo Standard Time",
+	"Tomsk Standard Time",
+	"Tonga Standard Time",
+	"Transbaikal Standard Time",
+	"Turkey Standard Time",
+	"Turks And Caicos Standard Time",
+	"Ulaanbaatar Standard Time",
+	"US Eastern Standard Time",
+	"US Mountain Standard Time",
+	"UTC",
+	"UTC+12",
+	"UTC+13",
+	"UTC-02",
+	"UTC-08",
+	"UTC-09",
+	"UTC-11",
+	"Venezuela Standard Time",
+	"Vladivostok Standard Time",
+	"Volgograd Standard Time",
+	"W. Australia Standard Time",
+	"W. Central Africa Standard Time",
+	"W. Europe Standard Time",
+	"W. Mongolia Standard Time",
+	"West Asia Standard Time",
+	"West Bank Standard Time",
+	"West Pacific Standard Time",
+	"Yakutsk Standard Time",
+	"Yukon Standard Time",
+}
+
+// validateDuration validates a positive duration (e.g. "10s" or "1h30m").
+func validateDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return diag.Errorf("invalid duration %q; expected a duration such as \"10s\" or \"1h30m\"", v.(string))
+	}
+
+	if duration <= 0 {
+		return diag.Errorf("invalid duration %q; expected a positive duration", v.(string))
+	}
+
+	return nil
+}
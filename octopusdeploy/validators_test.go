@@ -0,0 +1,54 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCronExpression(t *testing.T) {
+	validCronExpressions := []string{
+		"0 0 06 * * Mon-Fri",
+		"0 */15 * * * *",
+		"0 30 2 1,15 * ?",
+		"0 0 12 ? JAN-MAR 1#2",
+		"0 0 0 L * ?",
+		"0 0 0 15W * ?",
+		"0 0 0 ? * 5L",
+		"0 0 0 L-3 * ?",
+	}
+
+	for _, cronExpression := range validCronExpressions {
+		assert.False(t, validateCronExpression(cronExpression, cty.Path{}).HasError(), cronExpression)
+	}
+
+	invalidCronExpressions := []string{
+		"",
+		"* * * * *",
+		"0 0 24 * * *",
+		"0 60 * * * *",
+		"0 0 0 32 * *",
+		"0 0 0 * 13 *",
+		"0 0 0 * * Funday",
+		"? 0 0 * * *",
+		"0 0 0 * * 1#6",
+		"0 0 10-5 * * *",
+		"0 */0 * * * *",
+		"0 0 0 1,,2 * *",
+	}
+
+	for _, cronExpression := range invalidCronExpressions {
+		assert.True(t, validateCronExpression(cronExpression, cty.Path{}).HasError(), cronExpression)
+	}
+}
+
+func TestValidateTimeZone(t *testing.T) {
+	for _, timeZone := range []string{"UTC", "Australia/Brisbane", "E. Australia Standard Time", "Europe/London"} {
+		assert.False(t, validateTimeZone(timeZone, cty.Path{}).HasError(), timeZone)
+	}
+
+	for _, timeZone := range []string{"", "Local", "Mars/Olympus_Mons", "Eastern Time"} {
+		assert.True(t, validateTimeZone(timeZone, cty.Path{}).HasError(), timeZone)
+	}
+}